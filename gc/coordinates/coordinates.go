@@ -0,0 +1,267 @@
+// Package coordinates holds points in 2D and 3D coordinate systems and conversions between them.
+//
+// Angles are in radians. Polar and Cylindrical points are (r, theta) and (r, theta, z) where
+// theta is measured from the x axis. Spherical points are (r, theta, phi) where theta is the
+// polar angle measured from the z axis and phi is the azimuthal angle measured from the x axis.
+package coordinates
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+// System is the coordinate system a Point is given in
+type System int
+
+const (
+	// Cartesian is for (x, y) or (x, y, z) points
+	Cartesian System = iota
+	// Polar is for (r, theta) points
+	Polar
+	// Cylindrical is for (r, theta, z) points
+	Cylindrical
+	// Spherical is for (r, theta, phi) points
+	Spherical
+)
+
+var systemNames = map[System]string{
+	Cartesian:   "Cartesian",
+	Polar:       "Polar",
+	Cylindrical: "Cylindrical",
+	Spherical:   "Spherical",
+}
+
+// Point is a point in 2D or 3D space
+type Point interface {
+	// Returns the coordinate system of the Point
+	System() System
+
+	// Returns the number of coordinates of the Point, either 2 or 3
+	Dim() int
+
+	// Returns the coordinate at index
+	Get(index int) float64
+
+	// Returns a copy of the coordinates of the Point
+	Coordinates() []float64
+
+	// Returns the Point in coordinate system system.
+	// Returns error if system is not supported or does not have the same dimension as the Point
+	Convert(system System) (Point, error)
+
+	// Returns the Cartesian coordinates of the Point as a column Vector
+	Vector() v.Vector
+
+	// String will return the string representation of the Point, i.e Polar(1, 3.14)
+	String() string
+}
+
+type point struct {
+	system System
+	coords []float64
+}
+
+func (p *point) System() System { return p.system }
+
+func (p *point) Dim() int { return len(p.coords) }
+
+func (p *point) Get(index int) float64 { return p.coords[index] }
+
+func (p *point) Coordinates() []float64 {
+	coords := make([]float64, len(p.coords))
+	copy(coords, p.coords)
+	return coords
+}
+
+func (p *point) Convert(system System) (Point, error) {
+	if _, ok := systemNames[system]; !ok {
+		return nil, errors.New("Coordinate system not supported")
+	}
+	if system == p.system {
+		return newPoint(system, p.coords...), nil
+	}
+	if system == Polar && p.Dim() != 2 || (system == Cylindrical || system == Spherical) && p.Dim() != 3 {
+		return nil, fmt.Errorf("Can not convert a %dD point to %v coordinates", p.Dim(), systemNames[system])
+	}
+
+	switch {
+	case p.system == Cylindrical && system == Spherical:
+		r, theta, z := p.coords[0], p.coords[1], p.coords[2]
+		return MakeSpherical(math.Hypot(r, z), math.Atan2(r, z), theta), nil
+	case p.system == Spherical && system == Cylindrical:
+		r, theta, phi := p.coords[0], p.coords[1], p.coords[2]
+		return MakeCylindrical(r*math.Sin(theta), phi, r*math.Cos(theta)), nil
+	}
+
+	cartesian := p.cartesian()
+	if system == Cartesian {
+		return newPoint(Cartesian, cartesian...), nil
+	}
+	return fromCartesian(system, cartesian)
+}
+
+func (p *point) Vector() v.Vector {
+	vector := v.NewVector(v.ColSpace, p.Dim())
+	for index, coord := range p.cartesian() {
+		vector.Set(index, gcv.MakeValue(coord))
+	}
+	return vector
+}
+
+func (p *point) String() string {
+	coords := make([]string, len(p.coords))
+	for index, coord := range p.coords {
+		coords[index] = strconv.FormatFloat(coord, 'g', -1, 64)
+	}
+	return fmt.Sprintf("%s(%s)", systemNames[p.system], strings.Join(coords, ", "))
+}
+
+// cartesian returns the Cartesian coordinates of the point
+func (p *point) cartesian() []float64 {
+	switch p.system {
+	case Polar:
+		r, theta := p.coords[0], p.coords[1]
+		return []float64{r * math.Cos(theta), r * math.Sin(theta)}
+	case Cylindrical:
+		r, theta, z := p.coords[0], p.coords[1], p.coords[2]
+		return []float64{r * math.Cos(theta), r * math.Sin(theta), z}
+	case Spherical:
+		r, theta, phi := p.coords[0], p.coords[1], p.coords[2]
+		return []float64{r * math.Sin(theta) * math.Cos(phi), r * math.Sin(theta) * math.Sin(phi), r * math.Cos(theta)}
+	}
+	coords := make([]float64, len(p.coords))
+	copy(coords, p.coords)
+	return coords
+}
+
+// cartesianOf returns the Cartesian coordinates of point
+func cartesianOf(point Point) []float64 {
+	cartesian, _ := point.Convert(Cartesian)
+	return cartesian.Coordinates()
+}
+
+// fromCartesian returns the point with Cartesian coordinates coords in coordinate system system.
+// Returns error if system is not supported
+func fromCartesian(system System, coords []float64) (Point, error) {
+	switch system {
+	case Cartesian:
+		return newPoint(Cartesian, coords...), nil
+	case Polar:
+		x, y := coords[0], coords[1]
+		return MakePolar(math.Hypot(x, y), math.Atan2(y, x)), nil
+	case Cylindrical:
+		x, y, z := coords[0], coords[1], coords[2]
+		return MakeCylindrical(math.Hypot(x, y), math.Atan2(y, x), z), nil
+	case Spherical:
+		x, y, z := coords[0], coords[1], coords[2]
+		rho := math.Hypot(x, y)
+		return MakeSpherical(math.Hypot(rho, z), math.Atan2(rho, z), math.Atan2(y, x)), nil
+	}
+	return nil, errors.New("Coordinate system not supported")
+}
+
+func newPoint(system System, coords ...float64) Point {
+	point := new(point)
+	point.system = system
+	point.coords = make([]float64, len(coords))
+	copy(point.coords, coords)
+	return point
+}
+
+// MakePoint returns a Point in coordinate system system.
+// Returns error if the number of coordinates does not fit the coordinate system
+func MakePoint(system System, coords ...float64) (Point, error) {
+	switch system {
+	case Cartesian:
+		if len(coords) != 2 && len(coords) != 3 {
+			return nil, errors.New("Cartesian points need either 2 or 3 coordinates")
+		}
+	case Polar:
+		if len(coords) != 2 {
+			return nil, errors.New("Polar points need 2 coordinates")
+		}
+	case Cylindrical, Spherical:
+		if len(coords) != 3 {
+			return nil, fmt.Errorf("%v points need 3 coordinates", systemNames[system])
+		}
+	default:
+		return nil, errors.New("Coordinate system not supported")
+	}
+	return newPoint(system, coords...), nil
+}
+
+// MustMakePoint is the same as MakePoint, but will panic
+func MustMakePoint(system System, coords ...float64) Point {
+	point, err := MakePoint(system, coords...)
+	if err != nil {
+		panic(err)
+	}
+	return point
+}
+
+// MakeCartesian2D returns the Cartesian Point (x, y)
+func MakeCartesian2D(x, y float64) Point { return newPoint(Cartesian, x, y) }
+
+// MakeCartesian3D returns the Cartesian Point (x, y, z)
+func MakeCartesian3D(x, y, z float64) Point { return newPoint(Cartesian, x, y, z) }
+
+// MakePolar returns the Polar Point (r, theta)
+func MakePolar(r, theta float64) Point { return newPoint(Polar, r, theta) }
+
+// MakeCylindrical returns the Cylindrical Point (r, theta, z)
+func MakeCylindrical(r, theta, z float64) Point { return newPoint(Cylindrical, r, theta, z) }
+
+// MakeSpherical returns the Spherical Point (r, theta, phi)
+func MakeSpherical(r, theta, phi float64) Point { return newPoint(Spherical, r, theta, phi) }
+
+// MakePointFromVector returns the Point with Cartesian coordinates vector in coordinate system system.
+// Returns error if vector is not of length 2 or 3, has Complex Values or does not fit system
+func MakePointFromVector(system System, vector v.Vector) (Point, error) {
+	if vector.Len() != 2 && vector.Len() != 3 {
+		return nil, errors.New("Vector must be of length 2 or 3")
+	}
+	coords := make([]float64, vector.Len())
+	for index := range coords {
+		value := vector.Get(index)
+		if value.Type() != gcv.Real {
+			return nil, errors.New("Vector must only hold Real Values")
+		}
+		coords[index] = value.Real()
+	}
+	return newPoint(Cartesian, coords...).Convert(system)
+}
+
+// Distance returns the Euclidean distance between two Points of the same dimension
+func Distance(pointA Point, pointB Point) (float64, error) {
+	if pointA.Dim() != pointB.Dim() {
+		return 0, errors.New("Points are not of the same dimension")
+	}
+	coordsA := cartesianOf(pointA)
+	coordsB := cartesianOf(pointB)
+	var distance float64
+	for index := range coordsA {
+		distance = math.Hypot(distance, coordsA[index]-coordsB[index])
+	}
+	return distance, nil
+}
+
+// Midpoint returns the Point halfway between two Points of the same dimension,
+// in the coordinate system of pointA
+func Midpoint(pointA Point, pointB Point) (Point, error) {
+	if pointA.Dim() != pointB.Dim() {
+		return nil, errors.New("Points are not of the same dimension")
+	}
+	coordsA := cartesianOf(pointA)
+	coordsB := cartesianOf(pointB)
+	midpoint := make([]float64, len(coordsA))
+	for index := range coordsA {
+		midpoint[index] = coordsA[index] + (coordsB[index]-coordsA[index])/2
+	}
+	return fromCartesian(pointA.System(), midpoint)
+}
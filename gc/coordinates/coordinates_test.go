@@ -0,0 +1,166 @@
+package coordinates
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	mops "github.com/NumberXNumbers/types/gc/matrices/ops"
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+const tolerance = 1e-12
+
+func coordinatesClose(pointA Point, pointB Point) bool {
+	if pointA.System() != pointB.System() || pointA.Dim() != pointB.Dim() {
+		return false
+	}
+	for index := 0; index < pointA.Dim(); index++ {
+		if math.Abs(pointA.Get(index)-pointB.Get(index)) > tolerance {
+			return false
+		}
+	}
+	return true
+}
+
+func TestMakePoint(t *testing.T) {
+	if _, err := MakePoint(Cartesian, 1); err == nil {
+		t.Error("Expected Error")
+	}
+
+	if _, err := MakePoint(Polar, 1, 2, 3); err == nil {
+		t.Error("Expected Error")
+	}
+
+	if _, err := MakePoint(Spherical, 1, 2); err == nil {
+		t.Error("Expected Error")
+	}
+
+	if _, err := MakePoint(System(10), 1, 2); err == nil {
+		t.Error("Expected Error")
+	}
+
+	point := MustMakePoint(Cylindrical, 1, 2, 3)
+	if point.String() != "Cylindrical(1, 2, 3)" || point.Dim() != 3 || !reflect.DeepEqual(point.Coordinates(), []float64{1, 2, 3}) {
+		t.Errorf("Expected Cylindrical(1, 2, 3), received %v", point)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Printf("Recovered from %v error\n", r)
+		}
+	}()
+
+	result := MustMakePoint(Polar, 1)
+
+	if result != nil {
+		t.Error("Expected Panic")
+	}
+}
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		point    Point
+		solution Point
+	}{
+		{MakeCartesian2D(0, 2), MakePolar(2, math.Pi/2)},
+		{MakePolar(2, math.Pi/2), MakeCartesian2D(0, 2)},
+		{MakeCartesian3D(1, 1, 0), MakeCylindrical(math.Sqrt2, math.Pi/4, 0)},
+		{MakeCartesian3D(0, 0, -3), MakeSpherical(3, math.Pi, 0)},
+		{MakeSpherical(2, math.Pi/2, math.Pi/2), MakeCartesian3D(0, 2, 0)},
+		{MakeCylindrical(3, 1, 4), MakeSpherical(5, math.Atan2(3, 4), 1)},
+		{MakeSpherical(5, math.Atan2(3, 4), 1), MakeCylindrical(3, 1, 4)},
+		{MakeCylindrical(1, math.Pi, 2), MakeCartesian3D(-1, 0, 2)},
+	}
+
+	for _, test := range tests {
+		result, err := test.point.Convert(test.solution.System())
+		if err != nil || !coordinatesClose(result, test.solution) {
+			t.Errorf("Expected %v, received %v from %v", test.solution, result, test.point)
+		}
+	}
+
+	if _, err := MakeCartesian2D(1, 1).Convert(Spherical); err == nil {
+		t.Error("Expected Error")
+	}
+
+	if _, err := MakeSpherical(1, 1, 1).Convert(Polar); err == nil {
+		t.Error("Expected Error")
+	}
+
+	if _, err := MakeCartesian2D(1, 1).Convert(System(10)); err == nil {
+		t.Error("Expected Error")
+	}
+
+	if _, err := fromCartesian(System(10), []float64{1, 1}); err == nil {
+		t.Error("Expected Error")
+	}
+}
+
+func TestConvertRoundTrip(t *testing.T) {
+	point := MakeCartesian3D(1.5, -2.25, 0.75)
+	for _, system := range []System{Cylindrical, Spherical} {
+		converted, _ := point.Convert(system)
+		back, _ := converted.Convert(Cartesian)
+		if !coordinatesClose(back, point) {
+			t.Errorf("Expected %v, received %v via %v", point, back, converted)
+		}
+	}
+
+	cylindrical := MakeCylindrical(2, 0.3, -1)
+	spherical, _ := cylindrical.Convert(Spherical)
+	if back, _ := spherical.Convert(Cylindrical); back.Get(1) != 0.3 {
+		t.Errorf("Expected the azimuth to be carried over exactly, received %v", back)
+	}
+}
+
+func TestDistanceAndMidpoint(t *testing.T) {
+	pointA := MakeCartesian3D(1, 2, 3)
+	pointB := MakeSpherical(2, 0, 0)
+
+	if distance, err := Distance(pointA, pointB); err != nil || math.Abs(distance-math.Sqrt(6)) > tolerance {
+		t.Errorf("Expected %v, received %v", math.Sqrt(6), distance)
+	}
+
+	midpoint, err := Midpoint(pointB, pointA)
+	solution, _ := fromCartesian(Spherical, []float64{0.5, 1, 2.5})
+	if err != nil || !coordinatesClose(midpoint, solution) {
+		t.Errorf("Expected Spherical midpoint, received %v", midpoint)
+	}
+
+	if _, err := Distance(pointA, MakePolar(1, 1)); err == nil {
+		t.Error("Expected Error")
+	}
+
+	if _, err := Midpoint(pointA, MakePolar(1, 1)); err == nil {
+		t.Error("Expected Error")
+	}
+}
+
+func TestVectorConversion(t *testing.T) {
+	point := MakePolar(1, math.Pi/2)
+	rotation := m.MakeMatrix(
+		v.MakeVector(v.RowSpace, 0, -1),
+		v.MakeVector(v.RowSpace, 1, 0))
+
+	rotated, err := mops.MVMult(point.Vector(), rotation)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := MakePointFromVector(Polar, rotated)
+	if err != nil || !coordinatesClose(result, MakePolar(1, math.Pi)) {
+		t.Errorf("Expected %v, received %v", MakePolar(1, math.Pi), result)
+	}
+
+	if _, err := MakePointFromVector(Cartesian, v.MakeVector(v.ColSpace, 1)); err == nil {
+		t.Error("Expected Error")
+	}
+
+	if _, err := MakePointFromVector(Cartesian, v.MakeVector(v.ColSpace, 1, gcv.MakeValue(1i))); err == nil {
+		t.Error("Expected Error")
+	}
+}
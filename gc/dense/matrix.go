@@ -0,0 +1,272 @@
+//go:build go1.18
+// +build go1.18
+
+package dense
+
+import (
+	"errors"
+
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+// Matrix is a dense, row major, matrix with elements of type T
+type Matrix[T Scalar] struct {
+	numRows  int
+	numCols  int
+	elements []T
+}
+
+// Dim returns the dimensions of the matrix
+func (a *Matrix[T]) Dim() (rows, cols int) { return a.numRows, a.numCols }
+
+// GetNumRows returns the number of rows
+func (a *Matrix[T]) GetNumRows() int { return a.numRows }
+
+// GetNumCols returns the number of columns
+func (a *Matrix[T]) GetNumCols() int { return a.numCols }
+
+// TotalElements returns the number of elements of the matrix
+func (a *Matrix[T]) TotalElements() int { return len(a.elements) }
+
+// IsSquare returns true if the matrix is square
+func (a *Matrix[T]) IsSquare() bool { return a.numRows == a.numCols }
+
+// IsIdentity returns true if the matrix is an identity matrix
+func (a *Matrix[T]) IsIdentity() bool {
+	if !a.IsSquare() {
+		return false
+	}
+	one := FromFloat[T](1)
+	for i := 0; i < a.numRows; i++ {
+		for j := 0; j < a.numCols; j++ {
+			element := a.Get(i, j)
+			if (i == j && element != one) || (i != j && element != 0) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Get returns the element at location (row, col)
+func (a *Matrix[T]) Get(row int, col int) T { return a.elements[row*a.numCols+col] }
+
+// Set sets the element at location (row, col) to val
+func (a *Matrix[T]) Set(row int, col int, val T) { a.elements[row*a.numCols+col] = val }
+
+// Row returns a copy of row as a row vector
+func (a *Matrix[T]) Row(row int) *Vector[T] {
+	return MakeVectorAlt(v.RowSpace, a.elements[row*a.numCols:(row+1)*a.numCols])
+}
+
+// Col returns a copy of col as a column vector
+func (a *Matrix[T]) Col(col int) *Vector[T] {
+	vector := NewVector[T](v.ColSpace, a.numRows)
+	for i := 0; i < a.numRows; i++ {
+		vector.elements[i] = a.Get(i, col)
+	}
+	return vector
+}
+
+// Elements returns a copy of the row major elements of the matrix
+func (a *Matrix[T]) Elements() []T {
+	elements := make([]T, len(a.elements))
+	copy(elements, a.elements)
+	return elements
+}
+
+// Copy returns a copy of the matrix
+func (a *Matrix[T]) Copy() *Matrix[T] { return MakeMatrixAlt(a.numRows, a.numCols, a.elements) }
+
+// Trans transposes the matrix
+func (a *Matrix[T]) Trans() {
+	elements := make([]T, len(a.elements))
+	for i := 0; i < a.numRows; i++ {
+		for j := 0; j < a.numCols; j++ {
+			elements[j*a.numRows+i] = a.Get(i, j)
+		}
+	}
+	a.numRows, a.numCols = a.numCols, a.numRows
+	a.elements = elements
+}
+
+// Conj conjugates the matrix
+func (a *Matrix[T]) Conj() {
+	for index, element := range a.elements {
+		a.elements[index] = Conj(element)
+	}
+}
+
+// ConjTrans conjugates and transposes the matrix
+func (a *Matrix[T]) ConjTrans() {
+	a.Conj()
+	a.Trans()
+}
+
+// Swap swaps two matrix rows
+func (a *Matrix[T]) Swap(rowA, rowB int) {
+	for j := 0; j < a.numCols; j++ {
+		indexA, indexB := rowA*a.numCols+j, rowB*a.numCols+j
+		a.elements[indexA], a.elements[indexB] = a.elements[indexB], a.elements[indexA]
+	}
+}
+
+// Tr returns the trace of the matrix. Returns error if matrix is not square
+func (a *Matrix[T]) Tr() (T, error) {
+	var trace T
+	if !a.IsSquare() {
+		return trace, errors.New("Matrix is not square")
+	}
+	for i := 0; i < a.numRows; i++ {
+		trace += a.Get(i, i)
+	}
+	return trace, nil
+}
+
+// Det returns the determinate of the matrix or error if matrix is not square
+func (a *Matrix[T]) Det() (T, error) {
+	var det T
+	if !a.IsSquare() {
+		return det, errors.New("Matrix is not square")
+	}
+
+	lu := a.Copy()
+	det = FromFloat[T](1)
+	for i := 0; i < lu.numRows; i++ {
+		pivot := lu.pivotRow(i)
+		if lu.Get(pivot, i) == 0 {
+			return 0, nil
+		}
+		if pivot != i {
+			lu.Swap(i, pivot)
+			det = -det
+		}
+		for j := i + 1; j < lu.numRows; j++ {
+			factor := lu.Get(j, i) / lu.Get(i, i)
+			for k := i; k < lu.numCols; k++ {
+				lu.Set(j, k, lu.Get(j, k)-factor*lu.Get(i, k))
+			}
+		}
+		det *= lu.Get(i, i)
+	}
+	return det, nil
+}
+
+// Inv returns the inverse of the matrix. Returns error if there is no inverse
+func (a *Matrix[T]) Inv() (*Matrix[T], error) {
+	if !a.IsSquare() {
+		return nil, errors.New("Matrix is not square")
+	}
+
+	degree := a.numRows
+	work := a.Copy()
+	inverse := NewIdentityMatrix[T](degree)
+	for i := 0; i < degree; i++ {
+		pivot := work.pivotRow(i)
+		if work.Get(pivot, i) == 0 {
+			return nil, errors.New("Matrix does not have an inverse")
+		}
+		work.Swap(i, pivot)
+		inverse.Swap(i, pivot)
+
+		scale := work.Get(i, i)
+		for k := 0; k < degree; k++ {
+			work.Set(i, k, work.Get(i, k)/scale)
+			inverse.Set(i, k, inverse.Get(i, k)/scale)
+		}
+
+		for j := 0; j < degree; j++ {
+			if j == i {
+				continue
+			}
+			factor := work.Get(j, i)
+			for k := 0; k < degree; k++ {
+				work.Set(j, k, work.Get(j, k)-factor*work.Get(i, k))
+				inverse.Set(j, k, inverse.Get(j, k)-factor*inverse.Get(i, k))
+			}
+		}
+	}
+	return inverse, nil
+}
+
+// pivotRow returns the row at or below col with the largest absolute value in col
+func (a *Matrix[T]) pivotRow(col int) int {
+	pivot := col
+	for i := col + 1; i < a.numRows; i++ {
+		if Abs(a.Get(i, col)) > Abs(a.Get(pivot, col)) {
+			pivot = i
+		}
+	}
+	return pivot
+}
+
+// ToMatrix returns a m.Matrix holding the same elements as the matrix
+func (a *Matrix[T]) ToMatrix() m.Matrix {
+	matrix := m.NewMatrix(a.numRows, a.numCols)
+	for i := 0; i < a.numRows; i++ {
+		for j := 0; j < a.numCols; j++ {
+			matrix.Set(i, j, ToValue(a.Get(i, j)))
+		}
+	}
+	return matrix
+}
+
+// NewMatrix returns the zero matrix of size (rows, cols)
+func NewMatrix[T Scalar](rows int, cols int) *Matrix[T] {
+	return &Matrix[T]{numRows: rows, numCols: cols, elements: make([]T, rows*cols)}
+}
+
+// MakeMatrixAlt returns a matrix of size (rows, cols) holding a copy of the row major elements.
+// Will panic if the length of elements is not rows*cols
+func MakeMatrixAlt[T Scalar](rows int, cols int, elements []T) *Matrix[T] {
+	if len(elements) != rows*cols {
+		panic("Number of elements not equal to rows*cols")
+	}
+	matrix := NewMatrix[T](rows, cols)
+	copy(matrix.elements, elements)
+	return matrix
+}
+
+// MakeMatrix returns a new matrix with the vectors as its rows.
+// Rows shorter than the longest row are padded with zeros
+func MakeMatrix[T Scalar](rows ...*Vector[T]) *Matrix[T] {
+	var cols int
+	for _, row := range rows {
+		if row.Len() > cols {
+			cols = row.Len()
+		}
+	}
+	matrix := NewMatrix[T](len(rows), cols)
+	for i, row := range rows {
+		copy(matrix.elements[i*cols:], row.elements)
+	}
+	return matrix
+}
+
+// NewIdentityMatrix returns a new identity matrix of size (degree, degree)
+func NewIdentityMatrix[T Scalar](degree int) *Matrix[T] {
+	matrix := NewMatrix[T](degree, degree)
+	one := FromFloat[T](1)
+	for i := 0; i < degree; i++ {
+		matrix.Set(i, i, one)
+	}
+	return matrix
+}
+
+// MatrixFrom returns a Matrix holding the elements of the m.Matrix matrix.
+// An error is returned if an element can not be held by T
+func MatrixFrom[T Scalar](matrix m.Matrix) (*Matrix[T], error) {
+	rows, cols := matrix.Dim()
+	denseMatrix := NewMatrix[T](rows, cols)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			element, err := FromValue[T](matrix.Get(i, j))
+			if err != nil {
+				return nil, err
+			}
+			denseMatrix.Set(i, j, element)
+		}
+	}
+	return denseMatrix, nil
+}
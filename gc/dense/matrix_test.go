@@ -0,0 +1,142 @@
+//go:build go1.18
+// +build go1.18
+
+package dense
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+func TestGetAndSetMethodsMatrix(t *testing.T) {
+	testMatrix := NewMatrix[float64](2, 3)
+	testMatrix.Set(1, 2, 4)
+
+	if rows, cols := testMatrix.Dim(); rows != 2 || cols != 3 || testMatrix.TotalElements() != 6 {
+		t.Errorf("Expected (2, 3), received (%v, %v)", rows, cols)
+	}
+
+	if testMatrix.Get(1, 2) != 4 || testMatrix.Get(0, 0) != 0 {
+		t.Error("Failure: Get")
+	}
+
+	if !reflect.DeepEqual(testMatrix.Row(1).Elements(), []float64{0, 0, 4}) || testMatrix.Row(1).Space() != v.RowSpace {
+		t.Errorf("Expected [0 0 4], received %v", testMatrix.Row(1).Elements())
+	}
+
+	if !reflect.DeepEqual(testMatrix.Col(2).Elements(), []float64{0, 4}) || testMatrix.Col(2).Space() != v.ColSpace {
+		t.Errorf("Expected [0 4], received %v", testMatrix.Col(2).Elements())
+	}
+}
+
+func TestMakeMatrix(t *testing.T) {
+	testMatrix := MakeMatrix(MakeVector(v.RowSpace, 1.0, 2.0, 3.0), MakeVector(v.RowSpace, 4.0))
+
+	if !reflect.DeepEqual(testMatrix.Elements(), []float64{1, 2, 3, 4, 0, 0}) {
+		t.Errorf("Expected [1 2 3 4 0 0], received %v", testMatrix.Elements())
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Printf("Recovered from %v error\n", r)
+		}
+	}()
+
+	result := MakeMatrixAlt(2, 2, []float64{1, 2, 3})
+
+	if result != nil {
+		t.Error("Expected Panic")
+	}
+}
+
+func TestTransAndConjMethodsMatrix(t *testing.T) {
+	testMatrix := MakeMatrixAlt(2, 3, []complex128{1, 2i, 3, 4, 5, 6 - 1i})
+
+	testMatrix.Trans()
+	if !reflect.DeepEqual(testMatrix.Elements(), []complex128{1, 4, 2i, 5, 3, 6 - 1i}) || testMatrix.GetNumRows() != 3 {
+		t.Errorf("Expected transpose, received %v", testMatrix.Elements())
+	}
+
+	testMatrix.ConjTrans()
+	if !reflect.DeepEqual(testMatrix.Elements(), []complex128{1, -2i, 3, 4, 5, 6 + 1i}) || testMatrix.GetNumRows() != 2 {
+		t.Errorf("Expected conjugate transpose, received %v", testMatrix.Elements())
+	}
+}
+
+func TestIdentityAndTraceMatrix(t *testing.T) {
+	testMatrix := NewIdentityMatrix[complex128](3)
+
+	if !testMatrix.IsIdentity() || NewMatrix[float64](2, 2).IsIdentity() || NewMatrix[float64](2, 3).IsIdentity() {
+		t.Error("Failure: IsIdentity")
+	}
+
+	if trace, err := testMatrix.Tr(); err != nil || trace != 3 {
+		t.Errorf("Expected %v, received %v", 3, trace)
+	}
+
+	if _, err := NewMatrix[float64](2, 3).Tr(); err == nil {
+		t.Error("Expected Error")
+	}
+}
+
+func TestDetAndInvMatrix(t *testing.T) {
+	testMatrixA := MakeMatrixAlt(3, 3, []float64{0, 2, 1, 1, 1, 0, 3, 0, 1})
+
+	if det, err := testMatrixA.Det(); err != nil || det != -5 {
+		t.Errorf("Expected %v, received %v", -5, det)
+	}
+
+	inverse, err := testMatrixA.Inv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	solution := []float64{-0.2, 0.4, 0.2, 0.2, 0.6, -0.2, 0.6, -1.2, 0.4}
+	for index, element := range inverse.Elements() {
+		if math.Abs(element-solution[index]) > 1e-12 {
+			t.Errorf("Expected %v, received %v", solution, inverse.Elements())
+			break
+		}
+	}
+
+	testMatrixB := MakeMatrixAlt(2, 2, []complex128{1, 2i, 1i, -2})
+	if det, err := testMatrixB.Det(); err != nil || det != 0 {
+		t.Errorf("Expected %v, received %v", 0, det)
+	}
+
+	if _, err := testMatrixB.Inv(); err == nil {
+		t.Error("Expected Error")
+	}
+
+	if _, err := NewMatrix[float64](2, 3).Det(); err == nil {
+		t.Error("Expected Error")
+	}
+
+	if _, err := NewMatrix[float64](2, 3).Inv(); err == nil {
+		t.Error("Expected Error")
+	}
+}
+
+func TestConversionMatrix(t *testing.T) {
+	testMatrix := m.NewIdentityMatrix(2)
+	testMatrix.Set(0, 1, 3+1i)
+
+	if _, err := MatrixFrom[float64](testMatrix); err == nil {
+		t.Error("Expected Error")
+	}
+
+	denseMatrix, err := MatrixFrom[complex128](testMatrix)
+	if err != nil || !reflect.DeepEqual(denseMatrix.Elements(), []complex128{1, 3 + 1i, 0, 1}) {
+		t.Errorf("Expected [1 (3+1i) 0 1], received %v", denseMatrix)
+	}
+
+	matrix := denseMatrix.ToMatrix()
+	if !reflect.DeepEqual(matrix.Get(0, 1), gcv.MakeValue(3+1i)) || !reflect.DeepEqual(matrix.Get(1, 1), gcv.MakeValue(1)) {
+		t.Errorf("Expected %v, received %v", testMatrix, matrix)
+	}
+}
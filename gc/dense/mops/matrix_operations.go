@@ -0,0 +1,206 @@
+//go:build go1.18
+// +build go1.18
+
+package mops
+
+import (
+	"errors"
+
+	d "github.com/NumberXNumbers/types/gc/dense"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+// SMult is an operation for multiplying a matrix by a scalar
+func SMult[T d.Scalar](scalar T, matrix *d.Matrix[T]) *d.Matrix[T] {
+	newMatrix := matrix.Copy()
+	for i := 0; i < matrix.GetNumRows(); i++ {
+		for j := 0; j < matrix.GetNumCols(); j++ {
+			newMatrix.Set(i, j, scalar*matrix.Get(i, j))
+		}
+	}
+	return newMatrix
+}
+
+// SDiv will divide a matrix by a scalar
+func SDiv[T d.Scalar](scalar T, matrix *d.Matrix[T]) *d.Matrix[T] {
+	newMatrix := matrix.Copy()
+	for i := 0; i < matrix.GetNumRows(); i++ {
+		for j := 0; j < matrix.GetNumCols(); j++ {
+			newMatrix.Set(i, j, matrix.Get(i, j)/scalar)
+		}
+	}
+	return newMatrix
+}
+
+// VMMult will multiply a row vector V and a matrix M together by V*M
+func VMMult[T d.Scalar](vector *d.Vector[T], matrix *d.Matrix[T]) (*d.Vector[T], error) {
+	if vector.Space() != v.RowSpace {
+		return nil, errors.New("Vector is not in Row Space")
+	}
+	rows, cols := matrix.Dim()
+	if vector.Len() != rows {
+		return nil, errors.New("Vector Length not equal to the number of rows in Matrix")
+	}
+	newVector := d.NewVector[T](v.RowSpace, cols)
+	for j := 0; j < cols; j++ {
+		var sum T
+		for i := 0; i < rows; i++ {
+			sum += vector.Get(i) * matrix.Get(i, j)
+		}
+		newVector.Set(j, sum)
+	}
+	return newVector, nil
+}
+
+// MustVMMult is the same as VMMult, but will panic
+func MustVMMult[T d.Scalar](vector *d.Vector[T], matrix *d.Matrix[T]) *d.Vector[T] {
+	newVector, err := VMMult(vector, matrix)
+	if err != nil {
+		panic(err)
+	}
+	return newVector
+}
+
+// MVMult will multiply a column vector V and a matrix M together by M*V
+func MVMult[T d.Scalar](vector *d.Vector[T], matrix *d.Matrix[T]) (*d.Vector[T], error) {
+	if vector.Space() != v.ColSpace {
+		return nil, errors.New("Vector is not in Column Space")
+	}
+	rows, cols := matrix.Dim()
+	if vector.Len() != cols {
+		return nil, errors.New("Vector Length not equal to the number of columns in Matrix")
+	}
+	newVector := d.NewVector[T](v.ColSpace, rows)
+	for i := 0; i < rows; i++ {
+		var sum T
+		for j := 0; j < cols; j++ {
+			sum += matrix.Get(i, j) * vector.Get(j)
+		}
+		newVector.Set(i, sum)
+	}
+	return newVector, nil
+}
+
+// MustMVMult is the same as MVMult, but will panic
+func MustMVMult[T d.Scalar](vector *d.Vector[T], matrix *d.Matrix[T]) *d.Vector[T] {
+	newVector, err := MVMult(vector, matrix)
+	if err != nil {
+		panic(err)
+	}
+	return newVector
+}
+
+// MultSimple is an operation that will multiple two matrices of size (m X k) and (k X n) together
+func MultSimple[T d.Scalar](matrixA *d.Matrix[T], matrixB *d.Matrix[T]) (*d.Matrix[T], error) {
+	rows, inner := matrixA.Dim()
+	if inner != matrixB.GetNumRows() {
+		return nil, errors.New("Length of columns of matrix A not equal to length of rows of matrix B")
+	}
+	cols := matrixB.GetNumCols()
+
+	matrixAB := d.NewMatrix[T](rows, cols)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			var sum T
+			for k := 0; k < inner; k++ {
+				sum += matrixA.Get(i, k) * matrixB.Get(k, j)
+			}
+			matrixAB.Set(i, j, sum)
+		}
+	}
+	return matrixAB, nil
+}
+
+// MustMultSimple is the same as MultSimple, but will panic
+func MustMultSimple[T d.Scalar](matrixA *d.Matrix[T], matrixB *d.Matrix[T]) *d.Matrix[T] {
+	matrixAB, err := MultSimple(matrixA, matrixB)
+	if err != nil {
+		panic(err)
+	}
+	return matrixAB
+}
+
+// Add is an operation that will add two matrices together
+func Add[T d.Scalar](matrixA *d.Matrix[T], matrixB *d.Matrix[T]) (*d.Matrix[T], error) {
+	if matrixA.GetNumCols() != matrixB.GetNumCols() || matrixA.GetNumRows() != matrixB.GetNumRows() {
+		return nil, errors.New("Matrices do not have equivalent dimensions")
+	}
+
+	matrixAB := d.NewMatrix[T](matrixA.Dim())
+	for i := 0; i < matrixA.GetNumRows(); i++ {
+		for j := 0; j < matrixA.GetNumCols(); j++ {
+			matrixAB.Set(i, j, matrixA.Get(i, j)+matrixB.Get(i, j))
+		}
+	}
+	return matrixAB, nil
+}
+
+// MustAdd is the same as Add, but will panic
+func MustAdd[T d.Scalar](matrixA *d.Matrix[T], matrixB *d.Matrix[T]) *d.Matrix[T] {
+	matrixAB, err := Add(matrixA, matrixB)
+	if err != nil {
+		panic(err)
+	}
+	return matrixAB
+}
+
+// Sub is an operation that will subtract two matrices from one another
+func Sub[T d.Scalar](matrixA *d.Matrix[T], matrixB *d.Matrix[T]) (*d.Matrix[T], error) {
+	if matrixA.GetNumCols() != matrixB.GetNumCols() || matrixA.GetNumRows() != matrixB.GetNumRows() {
+		return nil, errors.New("Matrices do not have equivalent dimensions")
+	}
+
+	matrixAB := d.NewMatrix[T](matrixA.Dim())
+	for i := 0; i < matrixA.GetNumRows(); i++ {
+		for j := 0; j < matrixA.GetNumCols(); j++ {
+			matrixAB.Set(i, j, matrixA.Get(i, j)-matrixB.Get(i, j))
+		}
+	}
+	return matrixAB, nil
+}
+
+// MustSub is the same as Sub, but will panic
+func MustSub[T d.Scalar](matrixA *d.Matrix[T], matrixB *d.Matrix[T]) *d.Matrix[T] {
+	matrixAB, err := Sub(matrixA, matrixB)
+	if err != nil {
+		panic(err)
+	}
+	return matrixAB
+}
+
+// Pow is an operation that will raise a square matrix to int n by squaring and multiplying
+func Pow[T d.Scalar](matrix *d.Matrix[T], n int) (*d.Matrix[T], error) {
+	if !matrix.IsSquare() {
+		return nil, errors.New("Matrix is not square")
+	}
+
+	base := matrix
+	if n < 0 {
+		inverse, err := matrix.Inv()
+		if err != nil {
+			return nil, err
+		}
+		base, n = inverse, -n
+	}
+
+	result := d.NewIdentityMatrix[T](matrix.GetNumRows())
+	for n > 0 {
+		if n%2 == 1 {
+			result = MustMultSimple(result, base)
+		}
+		n /= 2
+		if n > 0 {
+			base = MustMultSimple(base, base)
+		}
+	}
+	return result, nil
+}
+
+// MustPow is the same as Pow, but will panic
+func MustPow[T d.Scalar](matrix *d.Matrix[T], n int) *d.Matrix[T] {
+	newMatrix, err := Pow(matrix, n)
+	if err != nil {
+		panic(err)
+	}
+	return newMatrix
+}
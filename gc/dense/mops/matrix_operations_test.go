@@ -0,0 +1,133 @@
+//go:build go1.18
+// +build go1.18
+
+package mops
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	d "github.com/NumberXNumbers/types/gc/dense"
+	om "github.com/NumberXNumbers/types/gc/matrices/ops"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+func TestSMultAndSDiv(t *testing.T) {
+	testMatrix := d.MakeMatrixAlt(2, 2, []complex128{1, 2, 2 + 2i, 2 - 4i})
+
+	if result := SMult(2, testMatrix); !reflect.DeepEqual(result.Elements(), []complex128{2, 4, 4 + 4i, 4 - 8i}) {
+		t.Errorf("Expected [2 4 (4+4i) (4-8i)], received %v", result.Elements())
+	}
+
+	if result := SDiv(2, testMatrix); !reflect.DeepEqual(result.Elements(), []complex128{0.5, 1, 1 + 1i, 1 - 2i}) {
+		t.Errorf("Expected [0.5 1 (1+1i) (1-2i)], received %v", result.Elements())
+	}
+}
+
+func TestVMMultAndMVMult(t *testing.T) {
+	testMatrix := d.MakeMatrixAlt(2, 3, []float64{1, 2, 3, 4, 5, 6})
+
+	if result, err := VMMult(d.MakeVector(v.RowSpace, 1.0, 1.0), testMatrix); err != nil ||
+		!reflect.DeepEqual(result.Elements(), []float64{5, 7, 9}) {
+		t.Errorf("Expected [5 7 9], received %v", result)
+	}
+
+	if result, err := MVMult(d.MakeVector(v.ColSpace, 1.0, 0.0, 1.0), testMatrix); err != nil ||
+		!reflect.DeepEqual(result.Elements(), []float64{4, 10}) {
+		t.Errorf("Expected [4 10], received %v", result)
+	}
+
+	if _, err := VMMult(d.MakeVector(v.ColSpace, 1.0, 1.0), testMatrix); err == nil {
+		t.Error("Expected Error")
+	}
+
+	if _, err := VMMult(d.MakeVector(v.RowSpace, 1.0), testMatrix); err == nil {
+		t.Error("Expected Error")
+	}
+
+	if _, err := MVMult(d.MakeVector(v.RowSpace, 1.0, 1.0, 1.0), testMatrix); err == nil {
+		t.Error("Expected Error")
+	}
+
+	if _, err := MVMult(d.MakeVector(v.ColSpace, 1.0), testMatrix); err == nil {
+		t.Error("Expected Error")
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Printf("Recovered from %v error\n", r)
+		}
+	}()
+
+	result := MustMVMult(d.MakeVector(v.ColSpace, 1.0), testMatrix)
+
+	if result != nil {
+		t.Error("Expected Panic")
+	}
+}
+
+func TestMultSimple(t *testing.T) {
+	testMatrixA := d.MakeMatrixAlt(2, 3, []complex128{1, 2i, 3, 4, 5, 6 - 1i})
+	testMatrixB := d.MakeMatrixAlt(3, 2, []complex128{1, 2, 3, 4i, 5, 6})
+
+	result, err := MultSimple(testMatrixA, testMatrixB)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	solution := om.MustMultSimple(testMatrixA.ToMatrix(), testMatrixB.ToMatrix())
+	if !reflect.DeepEqual(result.ToMatrix(), solution) {
+		t.Errorf("Expected %v, received %v", solution, result.ToMatrix())
+	}
+
+	if _, err := MultSimple(testMatrixA, testMatrixA); err == nil {
+		t.Error("Expected Error")
+	}
+}
+
+func TestAddAndSub(t *testing.T) {
+	testMatrixA := d.MakeMatrixAlt(2, 2, []float64{2, 0, 0, 2})
+	testMatrixB := d.NewIdentityMatrix[float64](2)
+
+	if result := MustAdd(testMatrixA, testMatrixB); !reflect.DeepEqual(result.Elements(), []float64{3, 0, 0, 3}) {
+		t.Errorf("Expected [3 0 0 3], received %v", result.Elements())
+	}
+
+	if result := MustSub(testMatrixA, testMatrixB); !reflect.DeepEqual(result.Elements(), []float64{1, 0, 0, 1}) {
+		t.Errorf("Expected [1 0 0 1], received %v", result.Elements())
+	}
+
+	if _, err := Add(testMatrixA, d.NewMatrix[float64](2, 3)); err == nil {
+		t.Error("Expected Error")
+	}
+
+	if _, err := Sub(testMatrixA, d.NewMatrix[float64](2, 3)); err == nil {
+		t.Error("Expected Error")
+	}
+}
+
+func TestPow(t *testing.T) {
+	testMatrix := d.MakeMatrixAlt(2, 2, []float64{2, 1, 1, 2})
+
+	if result := MustPow(testMatrix, 20); !reflect.DeepEqual(result.Elements(), []float64{1743392201, 1743392200, 1743392200, 1743392201}) {
+		t.Errorf("Expected [1743392201 1743392200 1743392200 1743392201], received %v", result.Elements())
+	}
+
+	if result := MustPow(testMatrix, 0); !result.IsIdentity() {
+		t.Errorf("Expected identity, received %v", result.Elements())
+	}
+
+	result, err := Pow(testMatrix, -1)
+	if err != nil || !reflect.DeepEqual(MustMultSimple(result, testMatrix).Elements(), []float64{1, 0, 0, 1}) {
+		t.Errorf("Expected inverse, received %v", result)
+	}
+
+	if _, err := Pow(d.MakeMatrixAlt(2, 2, []float64{1, 2, 2, 4}), -1); err == nil {
+		t.Error("Expected Error")
+	}
+
+	if _, err := Pow(d.NewMatrix[float64](2, 3), 2); err == nil {
+		t.Error("Expected Error")
+	}
+}
@@ -0,0 +1,63 @@
+//go:build go1.18
+// +build go1.18
+
+package dense
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+
+	gcv "github.com/NumberXNumbers/types/gc/values"
+)
+
+// Scalar is the set of element types a dense Vector or Matrix can hold
+type Scalar interface {
+	float64 | complex128
+}
+
+// Conj returns the conjugate of x. For float64 this is x itself
+func Conj[T Scalar](x T) T {
+	if c, ok := any(x).(complex128); ok {
+		return any(cmplx.Conj(c)).(T)
+	}
+	return x
+}
+
+// Abs returns the absolute value of x
+func Abs[T Scalar](x T) float64 {
+	if c, ok := any(x).(complex128); ok {
+		return cmplx.Abs(c)
+	}
+	return math.Abs(any(x).(float64))
+}
+
+// FromFloat returns the float64 f as a T
+func FromFloat[T Scalar](f float64) T {
+	var zero T
+	if _, ok := any(zero).(complex128); ok {
+		return any(complex(f, 0)).(T)
+	}
+	return any(f).(T)
+}
+
+// ToValue returns x as a gcv Value
+func ToValue[T Scalar](x T) gcv.Value {
+	return gcv.MakeValue(any(x))
+}
+
+// FromValue returns the gcv Value val as a T.
+// An error is returned if val can not be held by T without losing information
+func FromValue[T Scalar](val gcv.Value) (T, error) {
+	var zero T
+	if _, ok := any(zero).(complex128); ok {
+		if val.Type() > gcv.Complex {
+			return zero, errors.New("Value can not be represented as a complex128")
+		}
+		return any(val.Complex()).(T), nil
+	}
+	if val.Type() != gcv.Real {
+		return zero, errors.New("Value can not be represented as a float64")
+	}
+	return any(val.Real()).(T), nil
+}
@@ -0,0 +1,141 @@
+//go:build go1.18
+// +build go1.18
+
+// Package dense holds vectors and matrices whose elements are stored in a contiguous slice
+// of a single, compile time checked, Scalar type.
+package dense
+
+import (
+	"errors"
+	"math"
+
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+// Vector is a dense vector with elements of type T
+type Vector[T Scalar] struct {
+	space    v.Space
+	elements []T
+}
+
+// Len returns the length of the vector
+func (x *Vector[T]) Len() int { return len(x.elements) }
+
+// Get returns the element at index
+func (x *Vector[T]) Get(index int) T { return x.elements[index] }
+
+// Set sets the element at index to val
+func (x *Vector[T]) Set(index int, val T) { x.elements[index] = val }
+
+// Space returns the Space of the vector. Either Column or Row vector
+func (x *Vector[T]) Space() v.Space { return x.space }
+
+// Elements returns a copy of the elements in the vector
+func (x *Vector[T]) Elements() []T {
+	elements := make([]T, len(x.elements))
+	copy(elements, x.elements)
+	return elements
+}
+
+// Copy returns a new copy of the vector
+func (x *Vector[T]) Copy() *Vector[T] { return MakeVectorAlt(x.space, x.elements) }
+
+// Append appends val to the vector
+func (x *Vector[T]) Append(val T) { x.elements = append(x.elements, val) }
+
+// IndexOf returns the index of val. If val is not in the vector it returns -1
+func (x *Vector[T]) IndexOf(val T) int {
+	for index, element := range x.elements {
+		if element == val {
+			return index
+		}
+	}
+	return -1
+}
+
+// Trans transposes the vector. i.e changes a row into a column vector and vice versa
+func (x *Vector[T]) Trans() {
+	if x.space == v.ColSpace {
+		x.space = v.RowSpace
+	} else {
+		x.space = v.ColSpace
+	}
+}
+
+// Conj conjugates the vector
+func (x *Vector[T]) Conj() {
+	for index, element := range x.elements {
+		x.elements[index] = Conj(element)
+	}
+}
+
+// ConjTrans conjugates and transposes the vector
+func (x *Vector[T]) ConjTrans() {
+	x.Conj()
+	x.Trans()
+}
+
+// Norm returns the 2-norm of the vector
+func (x *Vector[T]) Norm() float64 {
+	var sum float64
+	for _, element := range x.elements {
+		abs := Abs(element)
+		sum += abs * abs
+	}
+	return math.Sqrt(sum)
+}
+
+// Unit returns the normalized unit vector of the vector. Will return error if the norm is equal to 0
+func (x *Vector[T]) Unit() (*Vector[T], error) {
+	norm := x.Norm()
+	if norm == 0 {
+		return nil, errors.New("Norm equal to zero")
+	}
+
+	unitVector := NewVector[T](x.space, x.Len())
+	scale := FromFloat[T](norm)
+	for index, element := range x.elements {
+		unitVector.elements[index] = element / scale
+	}
+	return unitVector, nil
+}
+
+// ToVector returns a v.Vector holding the same elements as the vector
+func (x *Vector[T]) ToVector() v.Vector {
+	vector := v.NewVector(x.space, x.Len())
+	for index, element := range x.elements {
+		vector.Set(index, ToValue(element))
+	}
+	return vector
+}
+
+// NewVector returns the zero vector of size length
+func NewVector[T Scalar](space v.Space, length int) *Vector[T] {
+	return &Vector[T]{space: space, elements: make([]T, length)}
+}
+
+// MakeVectorAlt returns a Vector holding a copy of the elements slice
+func MakeVectorAlt[T Scalar](space v.Space, elements []T) *Vector[T] {
+	vector := NewVector[T](space, len(elements))
+	copy(vector.elements, elements)
+	return vector
+}
+
+// MakeVector returns a Vector of elements
+func MakeVector[T Scalar](space v.Space, elements ...T) *Vector[T] {
+	return MakeVectorAlt(space, elements)
+}
+
+// VectorFrom returns a Vector holding the elements of the v.Vector vector.
+// An error is returned if an element can not be held by T
+func VectorFrom[T Scalar](vector v.Vector) (*Vector[T], error) {
+	denseVector := NewVector[T](vector.Space(), vector.Len())
+	for index := 0; index < vector.Len(); index++ {
+		element, err := FromValue[T](vector.Get(index))
+		if err != nil {
+			return nil, err
+		}
+		denseVector.elements[index] = element
+	}
+	return denseVector, nil
+}
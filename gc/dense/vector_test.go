@@ -0,0 +1,96 @@
+//go:build go1.18
+// +build go1.18
+
+package dense
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+func TestGetAndSetMethodsVector(t *testing.T) {
+	testVectorA := NewVector[float64](v.ColSpace, 3)
+	testVectorB := MakeVector(v.RowSpace, 1+1i, 2)
+
+	if testVectorA.Get(0) != 0 {
+		t.Errorf("Expected %v, received %v", 0, testVectorA.Get(0))
+	}
+
+	testVectorB.Set(1, 2+2i)
+
+	if testVectorB.Get(1) != 2+2i || testVectorB.Len() != 2 {
+		t.Errorf("Expected %v, received %v", 2+2i, testVectorB.Get(1))
+	}
+
+	testVectorB.Append(3)
+
+	if testVectorB.Len() != 3 || testVectorB.IndexOf(3) != 2 || testVectorB.IndexOf(4) != -1 {
+		t.Error("Failure: Append and IndexOf")
+	}
+}
+
+func TestTransAndConjMethodsVector(t *testing.T) {
+	testVector := MakeVector(v.RowSpace, 1+1i, 2-3i)
+
+	testVector.Trans()
+	if testVector.Space() != v.ColSpace {
+		t.Errorf("Expected %v, received %v", v.ColSpace, testVector.Space())
+	}
+
+	testVector.ConjTrans()
+	if testVector.Space() != v.RowSpace || testVector.Get(0) != 1-1i || testVector.Get(1) != 2+3i {
+		t.Errorf("Expected [(1-1i) (2+3i)], received %v", testVector.Elements())
+	}
+
+	testRealVector := MakeVector(v.RowSpace, 1.0, -2.0)
+	testRealVector.Conj()
+	if !reflect.DeepEqual(testRealVector.Elements(), []float64{1, -2}) {
+		t.Errorf("Expected [1 -2], received %v", testRealVector.Elements())
+	}
+}
+
+func TestNormAndUnitMethodsVector(t *testing.T) {
+	testVectorA := MakeVector(v.RowSpace, 3.0, 4.0)
+	testVectorB := MakeVector(v.RowSpace, 3i, 4)
+
+	if testVectorA.Norm() != 5 || testVectorB.Norm() != 5 {
+		t.Errorf("Expected %v, received %v and %v", 5, testVectorA.Norm(), testVectorB.Norm())
+	}
+
+	unit, err := testVectorB.Unit()
+	if err != nil || unit.Get(0) != 0.6i || math.Abs(unit.Norm()-1) > 1e-15 {
+		t.Errorf("Expected unit vector, received %v", unit)
+	}
+
+	if _, err := NewVector[float64](v.RowSpace, 2).Unit(); err == nil {
+		t.Error("Expected Error")
+	}
+}
+
+func TestConversionVector(t *testing.T) {
+	testVector := v.MakeVector(v.ColSpace, 1, 2+1i)
+
+	if _, err := VectorFrom[float64](testVector); err == nil {
+		t.Error("Expected Error")
+	}
+
+	denseVector, err := VectorFrom[complex128](testVector)
+	if err != nil || denseVector.Get(0) != 1 || denseVector.Get(1) != 2+1i || denseVector.Space() != v.ColSpace {
+		t.Errorf("Expected [1 (2+1i)], received %v", denseVector)
+	}
+
+	vector := denseVector.ToVector()
+	if !reflect.DeepEqual(vector.Get(0), gcv.MakeValue(1)) || !reflect.DeepEqual(vector.Get(1), gcv.MakeValue(2+1i)) {
+		t.Errorf("Expected %v, received %v", testVector, vector)
+	}
+
+	copyVector := denseVector.Copy()
+	copyVector.Set(0, 5)
+	if denseVector.Get(0) != 1 {
+		t.Error("Expected Copy to not alias the original vector")
+	}
+}
@@ -0,0 +1,99 @@
+//go:build go1.18
+// +build go1.18
+
+package vops
+
+import (
+	"errors"
+
+	d "github.com/NumberXNumbers/types/gc/dense"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+// SMult multiplies a vector by a scalar
+func SMult[T d.Scalar](scalar T, vector *d.Vector[T]) *d.Vector[T] {
+	newVector := d.NewVector[T](vector.Space(), vector.Len())
+	for i := 0; i < vector.Len(); i++ {
+		newVector.Set(i, scalar*vector.Get(i))
+	}
+	return newVector
+}
+
+// SDiv will divide a vector by a scalar
+func SDiv[T d.Scalar](scalar T, vector *d.Vector[T]) *d.Vector[T] {
+	newVector := d.NewVector[T](vector.Space(), vector.Len())
+	for i := 0; i < vector.Len(); i++ {
+		newVector.Set(i, vector.Get(i)/scalar)
+	}
+	return newVector
+}
+
+// InnerProduct returns the inner product of a row vector and a column vector
+func InnerProduct[T d.Scalar](vectorA *d.Vector[T], vectorB *d.Vector[T]) (T, error) {
+	var product T
+
+	if vectorA.Len() != vectorB.Len() {
+		return product, errors.New("Length of vectors does not match")
+	}
+
+	if vectorA.Space() != v.RowSpace || vectorB.Space() != v.ColSpace {
+		return product, errors.New("One or both vector types are not consistent with the vector inner product")
+	}
+
+	for i := 0; i < vectorA.Len(); i++ {
+		product += vectorA.Get(i) * vectorB.Get(i)
+	}
+	return product, nil
+}
+
+// OuterProduct returns the outer product of a column vector and a row vector
+func OuterProduct[T d.Scalar](vectorA *d.Vector[T], vectorB *d.Vector[T]) (*d.Matrix[T], error) {
+	if vectorA.Space() != v.ColSpace || vectorB.Space() != v.RowSpace {
+		return nil, errors.New("One or both vector types are not consistent with the vector outer product")
+	}
+
+	matrix := d.NewMatrix[T](vectorA.Len(), vectorB.Len())
+	for i := 0; i < vectorA.Len(); i++ {
+		for j := 0; j < vectorB.Len(); j++ {
+			matrix.Set(i, j, vectorA.Get(i)*vectorB.Get(j))
+		}
+	}
+	return matrix, nil
+}
+
+// Add adds two vectors together
+func Add[T d.Scalar](vectorA *d.Vector[T], vectorB *d.Vector[T]) (*d.Vector[T], error) {
+	if err := checkVectors(vectorA, vectorB); err != nil {
+		return nil, err
+	}
+
+	vector := d.NewVector[T](vectorA.Space(), vectorA.Len())
+	for i := 0; i < vectorA.Len(); i++ {
+		vector.Set(i, vectorA.Get(i)+vectorB.Get(i))
+	}
+	return vector, nil
+}
+
+// Sub subtracts two vectors
+func Sub[T d.Scalar](vectorA *d.Vector[T], vectorB *d.Vector[T]) (*d.Vector[T], error) {
+	if err := checkVectors(vectorA, vectorB); err != nil {
+		return nil, err
+	}
+
+	vector := d.NewVector[T](vectorA.Space(), vectorA.Len())
+	for i := 0; i < vectorA.Len(); i++ {
+		vector.Set(i, vectorA.Get(i)-vectorB.Get(i))
+	}
+	return vector, nil
+}
+
+func checkVectors[T d.Scalar](vectorA *d.Vector[T], vectorB *d.Vector[T]) error {
+	if vectorA.Space() != vectorB.Space() {
+		return errors.New("Vectors are not of same type. Must be both be either column vectors or row vectors")
+	}
+
+	if vectorA.Len() != vectorB.Len() {
+		return errors.New("Vectors are not same dimensions")
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+//go:build go1.18
+// +build go1.18
+
+package vops
+
+import (
+	"reflect"
+	"testing"
+
+	d "github.com/NumberXNumbers/types/gc/dense"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+func TestSMultAndSDiv(t *testing.T) {
+	testVector := d.MakeVector(v.ColSpace, 1.0, 2.0)
+
+	if result := SMult(2.0, testVector); !reflect.DeepEqual(result.Elements(), []float64{2, 4}) {
+		t.Errorf("Expected [2 4], received %v", result.Elements())
+	}
+
+	if result := SDiv(2.0, testVector); !reflect.DeepEqual(result.Elements(), []float64{0.5, 1}) {
+		t.Errorf("Expected [0.5 1], received %v", result.Elements())
+	}
+
+	testComplexVector := d.MakeVector(v.ColSpace, 1i, 2)
+	if result := SMult(2+1i, testComplexVector); !reflect.DeepEqual(result.Elements(), []complex128{-1 + 2i, 4 + 2i}) {
+		t.Errorf("Expected [(-1+2i) (4+2i)], received %v", result.Elements())
+	}
+}
+
+func TestInnerProduct(t *testing.T) {
+	testVectorA := d.MakeVector(v.RowSpace, 1.0, 2.0, 3.0)
+	testVectorB := d.MakeVector(v.ColSpace, 4.0, 5.0, 6.0)
+
+	if product, err := InnerProduct(testVectorA, testVectorB); err != nil || product != 32 {
+		t.Errorf("Expected %v, received %v", 32, product)
+	}
+
+	if _, err := InnerProduct(testVectorB, testVectorA); err == nil {
+		t.Error("Expected Error")
+	}
+
+	if _, err := InnerProduct(testVectorA, d.MakeVector(v.ColSpace, 1.0)); err == nil {
+		t.Error("Expected Error")
+	}
+}
+
+func TestOuterProduct(t *testing.T) {
+	testVectorA := d.MakeVector(v.ColSpace, 1i, 2)
+	testVectorB := d.MakeVector(v.RowSpace, 3, 4i)
+
+	matrix, err := OuterProduct(testVectorA, testVectorB)
+	if err != nil || !reflect.DeepEqual(matrix.Elements(), []complex128{3i, -4, 6, 8i}) {
+		t.Errorf("Expected [3i -4 6 8i], received %v", matrix)
+	}
+
+	if _, err := OuterProduct(testVectorB, testVectorA); err == nil {
+		t.Error("Expected Error")
+	}
+}
+
+func TestAddAndSub(t *testing.T) {
+	testVectorA := d.MakeVector(v.RowSpace, 1.0, 2.0)
+	testVectorB := d.MakeVector(v.RowSpace, 3.0, 5.0)
+
+	if result, err := Add(testVectorA, testVectorB); err != nil || !reflect.DeepEqual(result.Elements(), []float64{4, 7}) {
+		t.Errorf("Expected [4 7], received %v", result)
+	}
+
+	if result, err := Sub(testVectorA, testVectorB); err != nil || !reflect.DeepEqual(result.Elements(), []float64{-2, -3}) {
+		t.Errorf("Expected [-2 -3], received %v", result)
+	}
+
+	testVectorB.Trans()
+	if _, err := Add(testVectorA, testVectorB); err == nil {
+		t.Error("Expected Error")
+	}
+
+	if _, err := Sub(testVectorA, d.MakeVector(v.RowSpace, 1.0)); err == nil {
+		t.Error("Expected Error")
+	}
+}
@@ -0,0 +1,65 @@
+package functions
+
+import (
+	"context"
+	"runtime"
+	"sync"
+
+	args "github.com/NumberXNumbers/types/gc/functions/arguments"
+)
+
+// EvalBatch evaluates f at each row of inputs on workers goroutines, or on GOMAXPROCS goroutines if
+// workers is less than 1. The ith result and error are those of the ith row, as if from Eval.
+// If ctx is cancelled, rows that have not been evaluated are left with a nil result and the
+// error of ctx, which is also returned as err. Rows already being evaluated are finished
+func (f *Function) EvalBatch(ctx context.Context, inputs [][]interface{}, workers int) (results []args.Const, errs []error, err error) {
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > len(inputs) {
+		workers = len(inputs)
+	}
+	eval, compileErr := f.Compile()
+	if compileErr != nil {
+		eval = f.Eval
+	}
+
+	results = make([]args.Const, len(inputs))
+	errs = make([]error, len(inputs))
+	evaluated := make([]bool, len(inputs))
+	rows := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for row := range rows {
+				if ctx.Err() != nil {
+					continue
+				}
+				results[row], errs[row] = eval(inputs[row]...)
+				evaluated[row] = true
+			}
+		}()
+	}
+
+send:
+	for row := range inputs {
+		select {
+		case rows <- row:
+		case <-ctx.Done():
+			break send
+		}
+	}
+	close(rows)
+	wg.Wait()
+
+	if err = ctx.Err(); err != nil {
+		for row, done := range evaluated {
+			if !done {
+				errs[row] = err
+			}
+		}
+	}
+	return results, errs, err
+}
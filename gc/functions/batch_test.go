@@ -0,0 +1,93 @@
+package functions
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	args "github.com/NumberXNumbers/types/gc/functions/arguments"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+func TestEvalBatch(t *testing.T) {
+	x := args.NewVar(args.Value)
+	y := args.NewVar(args.Value)
+	function := MakeFuncPanic([]args.Var{x, y}, x, "*", y, "+", 1)
+
+	inputs := make([][]interface{}, 1000)
+	for i := range inputs {
+		inputs[i] = []interface{}{i, 2}
+	}
+	inputs[500] = []interface{}{v.MakeVector(v.RowSpace, 1), 2}
+	inputs[501] = []interface{}{1}
+
+	for _, workers := range []int{0, 1, 7} {
+		results, errs, err := function.EvalBatch(context.Background(), inputs, workers)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := range inputs {
+			if i == 500 || i == 501 {
+				if errs[i] == nil {
+					t.Errorf("Expected error for row %d", i)
+				}
+				continue
+			}
+			if errs[i] != nil || results[i].Value().Real() != float64(2*i+1) {
+				t.Errorf("Row %d: expected %v, received %v, %v", i, 2*i+1, results[i], errs[i])
+			}
+		}
+	}
+
+	if results, errs, err := function.EvalBatch(context.Background(), nil, 4); err != nil || len(results) != 0 || len(errs) != 0 {
+		t.Errorf("Expected no results, received %v, %v, %v", results, errs, err)
+	}
+}
+
+func TestEvalBatchCancel(t *testing.T) {
+	x := args.NewVar(args.Value)
+	function := MakeFuncPanic([]args.Var{x}, x, "+", 1)
+
+	inputs := make([][]interface{}, 100)
+	for i := range inputs {
+		inputs[i] = []interface{}{i}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results, errs, err := function.EvalBatch(ctx, inputs, 4)
+	if err != context.Canceled {
+		t.Fatalf("Expected %v, received %v", context.Canceled, err)
+	}
+	for i := range inputs {
+		if errs[i] != context.Canceled || results[i] != nil {
+			t.Errorf("Row %d: expected it not to be evaluated, received %v, %v", i, results[i], errs[i])
+		}
+	}
+}
+
+// TestEvalConcurrent is meant to be run with the race detector, go test -race
+func TestEvalConcurrent(t *testing.T) {
+	x := args.NewVar(args.Vector)
+	a := args.NewVar(args.Value)
+	function := MakeFuncPanic([]args.Var{x, a}, "Conj", "(", x, ")", "*", a, "+", v.MakeVector(v.RowSpace, 1, 1))
+	compiled := function.MustCompile()
+	vector := v.MakeVector(v.RowSpace, 1+1i, 2)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				result := function.MustEval(vector, i)
+				compiledResult, err := compiled(vector, i)
+				if err != nil || result.Vector().Get(0).Complex() != compiledResult.Vector().Get(0).Complex() {
+					t.Errorf("Expected %v, received %v, %v", result.Vector().Elements(), compiledResult, err)
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,95 @@
+package functions
+
+import (
+	"math/cmplx"
+	"testing"
+
+	args "github.com/NumberXNumbers/types/gc/functions/arguments"
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+func TestCompile(t *testing.T) {
+	x := args.NewVar(args.Value)
+	y := args.NewVar(args.Value)
+	regVars := []args.Var{x, y}
+
+	testCases := [][]interface{}{
+		{x, "*", x, "+", 3, "*", y, "-", 1},
+		{"Sin", "(", x, ")", "^", 2, "+", "Cos", "(", y, ")", "^", 2},
+		{"Sqrt", "(", x, "-", y, ")"},
+		{"Asin", "(", x, ")", "/", y},
+		{x, "^", y, "+", "Conj", "(", x, ")"},
+		{x, "*", 1 + 2i, "-", y},
+	}
+	inputs := [][]interface{}{{2, 3}, {0.5, -0.25}, {-4, 5}, {gcv.MakeValue(2 + 1i), 1.5}, {float32(1), int64(2)}}
+
+	for index, testCase := range testCases {
+		function := MakeFuncPanic(regVars, testCase...)
+		compiled := function.MustCompile()
+		for _, input := range inputs {
+			expected := function.MustEval(input...).Value().Complex()
+			received, err := compiled(input...)
+			if err != nil {
+				t.Errorf("Test case %d: unexpected error %v", index, err)
+				continue
+			}
+			if cmplx.Abs(expected-received.Value().Complex()) > 1e-12 {
+				t.Errorf("Test case %d with %v: expected %v, received %v", index, input, expected, received.Value())
+			}
+		}
+	}
+}
+
+func TestCompileVectors(t *testing.T) {
+	x := args.NewVar(args.Vector)
+	a := args.NewVar(args.Value)
+	function := MakeFuncPanic([]args.Var{x, a}, a, "*", x, "+", x)
+	compiled := function.MustCompile()
+
+	vector := v.MakeVector(v.RowSpace, 1, 2, 3)
+	result, err := compiled(vector, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Vector().Get(2).Real() != 9 {
+		t.Errorf("Expected %v, received %v", 9, result.Vector().Get(2))
+	}
+
+	if _, err := compiled(2, 2); err == nil {
+		t.Error("Expected error for a Value given for a Vector variable")
+	}
+	if _, err := compiled(vector); err == nil {
+		t.Error("Expected error for the wrong number of inputs")
+	}
+	if _, err := MakeFuncPanic([]args.Var{a}, a, a).Compile(); err == nil {
+		t.Error("Expected error for left over operands")
+	}
+}
+
+func benchmarkFunction() *Function {
+	x := args.NewVar(args.Value)
+	y := args.NewVar(args.Value)
+	return MakeFuncPanic([]args.Var{x, y}, "Sin", "(", x, ")", "*", y, "+", x, "^", 2, "/", "(", y, "+", 1, ")")
+}
+
+func BenchmarkEval(b *testing.B) {
+	function := benchmarkFunction()
+	for i := 0; i < b.N; i++ {
+		function.MustEval(0.5, 2.0)
+	}
+}
+
+func BenchmarkCompile(b *testing.B) {
+	compiled := benchmarkFunction().MustCompile()
+	for i := 0; i < b.N; i++ {
+		compiled(0.5, 2.0)
+	}
+}
+
+func BenchmarkCompileComplex(b *testing.B) {
+	compiled := benchmarkFunction().MustCompile()
+	for i := 0; i < b.N; i++ {
+		compiled(0.5+1i, 2.0)
+	}
+}
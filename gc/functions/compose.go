@@ -0,0 +1,84 @@
+package functions
+
+import (
+	"errors"
+
+	args "github.com/NumberXNumbers/types/gc/functions/arguments"
+)
+
+// Substitute returns a new Function with every occurrence of the variable x in f replaced by g.
+// The registered variables of the result are those of f without x, followed by those of g
+// that f does not have, and it has the operations of f.
+// Returns error if x is not registered in f or g uses an operation f does not have
+func (f *Function) Substitute(x args.Var, g *Function) (*Function, error) {
+	if _, ok := f.varNum[x]; !ok {
+		return nil, errors.New("Variable is not registered in function")
+	}
+	root, err := f.tree()
+	if err != nil {
+		return nil, err
+	}
+	replacement, err := g.tree()
+	if err != nil {
+		return nil, err
+	}
+	if err := checkOperations(replacement, g.registry, f.registry); err != nil {
+		return nil, err
+	}
+
+	var regVars []args.Var
+	for _, variable := range f.regVars {
+		if variable != x {
+			regVars = append(regVars, variable)
+		}
+	}
+	root = replace(root, map[args.Var]*node{x: replacement})
+	return fromTree(f.registry, mergeVars(regVars, g.regVars), root), nil
+}
+
+// MustSubstitute is the same as Substitute but will panic
+func (f *Function) MustSubstitute(x args.Var, g *Function) *Function {
+	function, err := f.Substitute(x, g)
+	if err != nil {
+		panic(err)
+	}
+	return function
+}
+
+// Compose returns the Function f(g1, g2, ...), with the output of the ith function in gs
+// fed into the ith registered variable of f. The registered variables of the result are those
+// of each function in gs, in order, without repeats, and it has the operations of f.
+// Returns error if the number of functions in gs is not the number of variables of f
+// or a function in gs uses an operation f does not have
+func Compose(f *Function, gs ...*Function) (*Function, error) {
+	if len(gs) != f.numVars {
+		return nil, errors.New("Number of functions is not equal to the number of variables in function")
+	}
+	root, err := f.tree()
+	if err != nil {
+		return nil, err
+	}
+
+	replacements := make(map[args.Var]*node)
+	regVars := make([][]args.Var, len(gs))
+	for i, g := range gs {
+		replacements[f.regVars[i]], err = g.tree()
+		if err != nil {
+			return nil, err
+		}
+		if err := checkOperations(replacements[f.regVars[i]], g.registry, f.registry); err != nil {
+			return nil, err
+		}
+		regVars[i] = g.regVars
+	}
+	return fromTree(f.registry, mergeVars(regVars...), replace(root, replacements)), nil
+}
+
+// MustCompose is the same as Compose but will panic
+func MustCompose(f *Function, gs ...*Function) *Function {
+	function, err := Compose(f, gs...)
+	if err != nil {
+		panic(err)
+	}
+	return function
+}
@@ -0,0 +1,59 @@
+package functions
+
+import (
+	"math"
+	"testing"
+
+	args "github.com/NumberXNumbers/types/gc/functions/arguments"
+)
+
+func TestSubstitute(t *testing.T) {
+	x := args.NewVar(args.Value)
+	y := args.NewVar(args.Value)
+	z := args.NewVar(args.Value)
+
+	f := MakeFuncPanic([]args.Var{x, y}, x, "*", x, "+", y)
+	g := MakeFuncPanic([]args.Var{z, y}, z, "-", y)
+
+	h := f.MustSubstitute(x, g)
+	// h(y, z) = (z - y)^2 + y
+	if value := h.MustEval(2, 5).Value().Real(); value != 11 {
+		t.Errorf("Expected %v, received %v", 11, value)
+	}
+
+	self := f.MustSubstitute(x, MakeFuncPanic([]args.Var{x}, x, "+", 1))
+	// self(y, x) = (x + 1)^2 + y
+	if value := self.MustEval(3, 2).Value().Real(); value != 12 {
+		t.Errorf("Expected %v, received %v", 12, value)
+	}
+
+	if _, err := f.Substitute(z, g); err == nil {
+		t.Error("Expected error for a variable that is not registered")
+	}
+}
+
+func TestCompose(t *testing.T) {
+	x := args.NewVar(args.Value)
+	y := args.NewVar(args.Value)
+
+	f := MakeFuncPanic([]args.Var{x, y}, x, "/", y)
+	g1 := MakeFuncPanic([]args.Var{x}, "Sin", "(", x, ")")
+	g2 := MakeFuncPanic([]args.Var{x, y}, "Cos", "(", x, ")", "*", y)
+
+	h := MustCompose(f, g1, g2)
+	// h(x, y) = Sin(x) / (Cos(x) * y)
+	expected := math.Tan(0.5) / 2
+	if value := h.MustEval(0.5, 2).Value().Real(); math.Abs(value-expected) > 1e-15 {
+		t.Errorf("Expected %v, received %v", expected, value)
+	}
+
+	// the variables of f and of the inner functions are replaced at once, swap(y, x) = y / x
+	swap := MustCompose(f, MakeFuncPanic([]args.Var{y}, y), MakeFuncPanic([]args.Var{x}, x))
+	if value := swap.MustEval(1, 4).Value().Real(); value != 0.25 {
+		t.Errorf("Expected %v, received %v", 0.25, value)
+	}
+
+	if _, err := Compose(f, g1); err == nil {
+		t.Error("Expected error for the wrong number of functions")
+	}
+}
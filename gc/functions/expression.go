@@ -0,0 +1,148 @@
+package functions
+
+import (
+	"errors"
+	"fmt"
+
+	args "github.com/NumberXNumbers/types/gc/functions/arguments"
+)
+
+// node is one node of the expression tree of a Function. A leaf holds either a constant or a
+// variable, any other node holds an operation applied to its operands
+type node struct {
+	constant  args.Const
+	variable  args.Var
+	operation string
+	operands  []*node
+}
+
+func constNode(c args.Const) *node { return &node{constant: c} }
+
+func opNode(operation string, operands ...*node) *node {
+	return &node{operation: operation, operands: operands}
+}
+
+// isConst returns true if n is a constant leaf
+func (n *node) isConst() bool { return n.constant != nil }
+
+// tree returns the expression tree of the postfix Args of f
+func (f *Function) tree() (*node, error) {
+	var stack []*node
+	for i := range f.Args {
+		switch f.typeInput(i) {
+		case args.Constant:
+			stack = append(stack, constNode(f.Args[i].(args.Const)))
+		case args.Variable:
+			stack = append(stack, &node{variable: f.Args[i].(args.Var)})
+		case args.Operation:
+			operation, err := f.getOp(i)
+			if err != nil {
+				return nil, err
+			}
+			o, ok := f.registry.lookup(operation)
+			if !ok {
+				return nil, errors.New("Operation not supported")
+			}
+			n := o.arity
+			if len(stack) < n {
+				return nil, errors.New("Not enough operands")
+			}
+			operands := append([]*node(nil), stack[len(stack)-n:]...)
+			stack = append(stack[:len(stack)-n], opNode(operation, operands...))
+		}
+	}
+
+	if len(stack) == 0 {
+		return nil, errors.New("Function has nothing to evaluate")
+	}
+	if len(stack) > 1 {
+		return nil, errors.New("To many operands left over after calculation")
+	}
+	return stack[0], nil
+}
+
+// postfix appends the postfix form of n to the Args of f
+func (f *Function) postfix(n *node) {
+	index := len(f.Args)
+	switch {
+	case n.isConst():
+		f.Args = append(f.Args, n.constant)
+		f.inputTypes[index] = args.Constant
+	case n.variable != nil:
+		f.Args = append(f.Args, n.variable)
+		f.inputTypes[index] = args.Variable
+	default:
+		for _, operand := range n.operands {
+			f.postfix(operand)
+		}
+		f.inputTypes[len(f.Args)] = args.Operation
+		f.Args = append(f.Args, n.operation)
+	}
+}
+
+// fromTree returns a new Function of root with the operations of registry and the registered
+// variables regVars, which must not hold duplicates
+func fromTree(registry *Registry, regVars []args.Var, root *node) *Function {
+	function := new(Function)
+	function.registry = registry
+	function.regVars = regVars
+	function.varNum = make(map[args.Var]int)
+	for i, variable := range regVars {
+		function.varNum[variable] = i
+	}
+	function.numVars = len(regVars)
+	function.inputTypes = make(map[int]args.Type)
+	function.postfix(root)
+	return function
+}
+
+// replace returns the tree of n with every variable that is a key of replacements replaced by its tree.
+// All of the variables are replaced at once, so a replacement is never itself replaced
+func replace(n *node, replacements map[args.Var]*node) *node {
+	if n.variable != nil {
+		if replacement, ok := replacements[n.variable]; ok {
+			return replacement
+		}
+		return n
+	}
+	if n.isConst() {
+		return n
+	}
+	operands := make([]*node, len(n.operands))
+	for i, operand := range n.operands {
+		operands[i] = replace(operand, replacements)
+	}
+	return opNode(n.operation, operands...)
+}
+
+// mergeVars returns the variables of each list in order, leaving out repeats
+func mergeVars(lists ...[]args.Var) []args.Var {
+	var merged []args.Var
+	seen := make(map[args.Var]bool)
+	for _, list := range lists {
+		for _, variable := range list {
+			if !seen[variable] {
+				seen[variable] = true
+				merged = append(merged, variable)
+			}
+		}
+	}
+	return merged
+}
+
+// checkOperations returns error if an operation of n is not the same operation in registry as in from
+func checkOperations(n *node, from *Registry, registry *Registry) error {
+	if n.operation == "" {
+		return nil
+	}
+	o, _ := from.lookup(n.operation)
+	if same, ok := registry.lookup(n.operation); !ok || same != o {
+		return fmt.Errorf("Operation %s is not in the registry of the function", n.operation)
+	}
+	for _, operand := range n.operands {
+		if err := checkOperations(operand, from, registry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -6,15 +6,14 @@ import (
 
 	args "github.com/NumberXNumbers/types/gc/functions/arguments"
 	"github.com/NumberXNumbers/types/gc/functions/ops"
-	m "github.com/NumberXNumbers/types/gc/matrices"
-	gcv "github.com/NumberXNumbers/types/gc/values"
-	v "github.com/NumberXNumbers/types/gc/vectors"
 )
 
 var (
 	leftParen  = "("
 	rightParen = ")"
 	pow        = "^"
+	// neg is the operation of a "-" in front of its operand, such as -x
+	neg        = "Neg"
 	unaryFuncs = map[string]func(args.Const) (args.Const, error){
 		"Sqrt":  ops.Sqrt,
 		"Conj":  ops.Conj,
@@ -38,8 +37,22 @@ var (
 		"/": ops.Div,
 		pow: ops.Pow,
 	}
+	// binaryPrefixFuncs are the functions of two operands, written as "Max", "(", a, ",", b, ")"
+	binaryPrefixFuncs = map[string]func(args.Const, args.Const) (args.Const, error){
+		"Atan2":    ops.Atan2,
+		"LogBase":  ops.LogBase,
+		"Max":      ops.Max,
+		"Min":      ops.Min,
+		"Mod":      ops.Mod,
+		"Aug":      ops.Aug,
+		"Kron":     ops.Kron,
+		"Hadamard": ops.Hadamard,
+		"Cross":    ops.Cross,
+	}
 	orderOfOperations = map[string]uint{
 		pow: 3,
+		// -x^2 is -(x^2) but -x*y is (-x)*y
+		neg: 2,
 		"*": 2,
 		"/": 2,
 		"+": 1,
@@ -54,6 +67,7 @@ type Function struct {
 	varNum     map[args.Var]int
 	numVars    int
 	regVars    []args.Var
+	registry   *Registry
 }
 
 func newConstVar(c args.Const) args.Var {
@@ -78,15 +92,14 @@ func (f *Function) getOp(i int) (string, error) {
 
 func (f *Function) typeInput(x int) args.Type { return f.inputTypes[x] }
 
-// Eval will evaluate a function
+// Eval will evaluate a function.
+// Eval does not change the Function, so it is safe to call from many goroutines at once
 func (f *Function) Eval(inputs ...interface{}) (args.Const, error) {
 	lenInputs := len(inputs)
 	if lenInputs != f.numVars {
 		return nil, errors.New("Number of inputs is not equal to the number of variables in function")
 	}
 
-	var operand1 args.Const
-	var operand2 args.Const
 	var operandStack []args.Const
 
 	i := 0
@@ -116,34 +129,14 @@ func (f *Function) Eval(inputs ...interface{}) (args.Const, error) {
 				return nil, err
 			}
 
-			if h, ok := unaryFuncs[operation]; ok {
-				if len(operandStack) == 0 {
-					return nil, errors.New("Not enough operands")
-				}
-
-				operand1, operandStack = operandStack[len(operandStack)-1], operandStack[:len(operandStack)-1]
-				result, err := h(operand1)
-				if err != nil {
-					return nil, err
-				}
-
-				operandStack = append(operandStack, result)
-			} else if h, ok := binaryFuncs[operation]; ok {
-				if len(operandStack) < 2 {
-					return nil, errors.New("Not enough operands")
-				}
-
-				operand2, operandStack = operandStack[len(operandStack)-1], operandStack[:len(operandStack)-1]
-				operand1, operandStack = operandStack[len(operandStack)-1], operandStack[:len(operandStack)-1]
-				result, err := h(operand1, operand2)
-				if err != nil {
-					return nil, err
-				}
-
-				operandStack = append(operandStack, result)
-			} else {
+			o, ok := f.registry.lookup(operation)
+			if !ok {
 				return nil, errors.New("Operation not supported")
 			}
+			operandStack, err = o.apply(operandStack)
+			if err != nil {
+				return nil, err
+			}
 		}
 		i++
 	}
@@ -167,13 +160,31 @@ func (f *Function) MustEval(inputs ...interface{}) args.Const {
 // MakeFunc will make a gcf function struct.
 // Else error
 func MakeFunc(regVars []args.Var, inputs ...interface{}) (*Function, error) {
+	return MakeFuncWith(builtins, regVars, inputs...)
+}
+
+// MakeFuncPanic will the same as MakeFunc but will panic
+func MakeFuncPanic(regVars []args.Var, inputs ...interface{}) *Function {
+	function, err := MakeFunc(regVars, inputs...)
+	if err != nil {
+		panic(err)
+	}
+	return function
+}
+
+// MakeFuncWith is the same as MakeFunc but the function can use the operations of registry,
+// which it keeps for Eval and every other method. A nil registry has the built in operations.
+// Else error
+func MakeFuncWith(registry *Registry, regVars []args.Var, inputs ...interface{}) (*Function, error) {
+	if registry == nil {
+		registry = builtins
+	}
 	function := new(Function)
 
+	function.registry = registry
 	function.regVars = regVars
 	var varNum = make(map[args.Var]int)
 	var numVars int
-	var tempOpsStack []string
-	var postfixStack []interface{}
 	for i, v := range regVars {
 		if _, ok := varNum[v]; !ok {
 			varNum[v] = numVars
@@ -183,122 +194,10 @@ func MakeFunc(regVars []args.Var, inputs ...interface{}) (*Function, error) {
 		return nil, fmt.Errorf("Error registering variables. Variable at index %d, is a duplicate", i)
 
 	}
-	var inputType = make(map[int]args.Type)
-	for i, n := range inputs {
-		topIndexInPostfixStack := len(postfixStack) - 1
-		switch n.(type) {
-		case string:
-			operation := n.(string)
-			var finishComparing bool
-			topIndexInTempOpsStack := len(tempOpsStack) - 1
-			if len(tempOpsStack) == 0 ||
-				(tempOpsStack[topIndexInTempOpsStack] == leftParen && operation != rightParen) {
-				tempOpsStack = append(tempOpsStack, operation)
-			} else if operation == leftParen {
-				tempOpsStack = append(tempOpsStack, operation)
-			} else if operation == rightParen {
-				for !finishComparing {
-					if len(tempOpsStack) == 0 {
-						return nil, errors.New("Mismatch of Parentheses found")
-					}
-					topOperationInTempOpsStack := tempOpsStack[topIndexInTempOpsStack]
-					if topOperationInTempOpsStack == leftParen {
-						tempOpsStack = tempOpsStack[:topIndexInTempOpsStack]
-						finishComparing = true
-					} else {
-						inputType[topIndexInPostfixStack+1] = args.Operation
-						postfixStack, tempOpsStack = append(postfixStack, topOperationInTempOpsStack), tempOpsStack[:topIndexInTempOpsStack]
-					}
-					topIndexInTempOpsStack = len(tempOpsStack) - 1
-					topIndexInPostfixStack = len(postfixStack) - 1
-				}
-			} else {
-				topOperationInTempOpsStack := tempOpsStack[topIndexInTempOpsStack]
-				var isPreviousUnary bool
-				var isUnary bool
-				if _, ok := unaryFuncs[topOperationInTempOpsStack]; ok {
-					isPreviousUnary = true
-				}
-				if _, ok := unaryFuncs[operation]; ok {
-					isUnary = true
-				}
-				if isPreviousUnary || orderOfOperations[operation] < orderOfOperations[topOperationInTempOpsStack] {
-					for !finishComparing {
-						if isUnary && isPreviousUnary {
-							tempOpsStack = append(tempOpsStack, operation)
-							finishComparing = true
-						} else if (topOperationInTempOpsStack == leftParen ||
-							orderOfOperations[operation] > orderOfOperations[topOperationInTempOpsStack] ||
-							isUnary) &&
-							!isPreviousUnary {
-							tempOpsStack = append(tempOpsStack, operation)
-							finishComparing = true
-						} else if orderOfOperations[operation] == orderOfOperations[topOperationInTempOpsStack] {
-							if operation == pow {
-								tempOpsStack = append(tempOpsStack, operation)
-								finishComparing = true
-							} else {
-								inputType[topIndexInPostfixStack+1] = args.Operation
-								postfixStack, tempOpsStack = append(postfixStack, topOperationInTempOpsStack), tempOpsStack[:topIndexInTempOpsStack]
-								topIndexInTempOpsStack = len(tempOpsStack) - 1
-							}
-						} else if orderOfOperations[operation] < orderOfOperations[topOperationInTempOpsStack] || isPreviousUnary {
-							inputType[topIndexInPostfixStack+1] = args.Operation
-							postfixStack, tempOpsStack = append(postfixStack, topOperationInTempOpsStack), tempOpsStack[:topIndexInTempOpsStack]
-							topIndexInTempOpsStack = len(tempOpsStack) - 1
-						}
 
-						if len(tempOpsStack) == 0 {
-							tempOpsStack = append(tempOpsStack, operation)
-							finishComparing = true
-						} else {
-							topOperationInTempOpsStack = tempOpsStack[topIndexInTempOpsStack]
-							topIndexInPostfixStack = len(postfixStack) - 1
-							if _, ok := unaryFuncs[topOperationInTempOpsStack]; !ok {
-								isPreviousUnary = false
-							}
-						}
-					}
-				} else if orderOfOperations[operation] > orderOfOperations[topOperationInTempOpsStack] {
-					tempOpsStack = append(tempOpsStack, operation)
-				} else if orderOfOperations[operation] == orderOfOperations[topOperationInTempOpsStack] {
-					if operation == pow {
-						tempOpsStack = append(tempOpsStack, operation)
-					} else {
-						inputType[topIndexInPostfixStack+1] = args.Operation
-						postfixStack, tempOpsStack = append(postfixStack, topOperationInTempOpsStack), tempOpsStack[:topIndexInTempOpsStack]
-						tempOpsStack = append(tempOpsStack, operation)
-					}
-				}
-			}
-		case int, int32, int64, float32, float64, complex64, complex128, gcv.Value, v.Vector, m.Matrix:
-			postfixStack = append(postfixStack, args.MakeConst(inputs[i]))
-			inputType[topIndexInPostfixStack+1] = args.Constant
-		case args.Const:
-			postfixStack = append(postfixStack, n)
-			inputType[topIndexInPostfixStack+1] = args.Constant
-		case args.Var:
-			if _, ok := varNum[n.(args.Var)]; !ok {
-				return nil, fmt.Errorf("Variable at index %d, was not registered", i)
-
-			}
-			postfixStack = append(postfixStack, n)
-			inputType[topIndexInPostfixStack+1] = args.Variable
-		default:
-			return nil, errors.New("Input type not supported")
-		}
-	}
-
-	for len(tempOpsStack) > 0 {
-		topIndexInTempOpsStack := len(tempOpsStack) - 1
-		topIndexInPostfixStack := len(postfixStack) - 1
-		var operation string
-		operation, tempOpsStack = tempOpsStack[topIndexInTempOpsStack], tempOpsStack[:topIndexInTempOpsStack]
-		if operation == "(" {
-			return nil, errors.New("Mismatch of Parentheses found")
-		}
-		inputType[topIndexInPostfixStack+1] = args.Operation
-		postfixStack = append(postfixStack, operation)
+	postfixStack, inputType, err := registry.parse(varNum, inputs)
+	if err != nil {
+		return nil, err
 	}
 
 	function.inputTypes = inputType
@@ -308,9 +207,9 @@ func MakeFunc(regVars []args.Var, inputs ...interface{}) (*Function, error) {
 	return function, nil
 }
 
-// MakeFuncPanic will the same as MakeFunc but will panic
-func MakeFuncPanic(regVars []args.Var, inputs ...interface{}) *Function {
-	function, err := MakeFunc(regVars, inputs...)
+// MakeFuncWithPanic is the same as MakeFuncWith but will panic
+func MakeFuncWithPanic(registry *Registry, regVars []args.Var, inputs ...interface{}) *Function {
+	function, err := MakeFuncWith(registry, regVars, inputs...)
 	if err != nil {
 		panic(err)
 	}
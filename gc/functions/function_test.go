@@ -537,3 +537,84 @@ func TestPanicBadgetVarFunc(t *testing.T) {
 		t.Error("Expected Error")
 	}
 }
+
+func TestCalculateMultiArgument(t *testing.T) {
+	testCases := []struct {
+		inputs   []interface{}
+		expected float64
+	}{
+		{[]interface{}{"Atan2", "(", 1, ",", -1, ")"}, 3 * math.Pi / 4},
+		{[]interface{}{"LogBase", "(", 8, ",", 2, ")"}, 3},
+		{[]interface{}{"Max", "(", 2, ",", 3, ")", "*", 2}, 6},
+		{[]interface{}{"Min", "(", 2, "+", 3, ",", 2, "*", 3, ")"}, 5},
+		{[]interface{}{"Mod", "(", 7, ",", 3, ")", pow, 2}, 1},
+		{[]interface{}{"Max", "(", "Min", "(", 1, ",", 2, ")", ",", "(", 4, "-", 5, ")", ")"}, 1},
+		{[]interface{}{"Sqrt", "(", "Max", "(", 16, ",", "Sin", 0, ")", ")"}, 4},
+	}
+
+	for _, testCase := range testCases {
+		calculation, err := Calculate(testCase.inputs...)
+		if err != nil || math.Abs(calculation.Value().Real()-testCase.expected) > 1e-15 {
+			t.Errorf("Expected %v for %v, received %v, %v", testCase.expected, testCase.inputs, calculation, err)
+		}
+	}
+}
+
+func TestCalculateMultiArgumentErrors(t *testing.T) {
+	testCases := [][]interface{}{
+		{"Max", "(", 2, ")"},
+		{"Max", "(", 2, ",", 3, ",", 4, ")"},
+		{"Max", 2, ",", 3},
+		{"Max"},
+		{2, ",", 3},
+		{"(", 2, ",", 3, ")"},
+		{"Sin", "(", 2, ",", 3, ")"},
+		{"Max", "(", 2, ",", 3},
+		{"Mod", "(", 1i, ",", 2, ")"},
+		{"Atan2", "(", v.MakeVector(v.RowSpace, 1, 2), ",", 2, ")"},
+	}
+
+	for _, inputs := range testCases {
+		if _, err := Calculate(inputs...); err == nil {
+			t.Errorf("Expected error for %v", inputs)
+		}
+	}
+}
+
+func TestFuncMultiArgument(t *testing.T) {
+	x := args.NewVar(args.Value)
+	y := args.NewVar(args.Value)
+	regVars := []args.Var{x, y}
+
+	function := MakeFuncPanic(regVars, "Atan2", "(", y, ",", x, ")", "+", "Max", "(", x, ",", y, ")")
+	if value := function.MustEval(1, 1); math.Abs(value.Value().Real()-(math.Pi/4+1)) > 1e-15 {
+		t.Errorf("Expected %v, received %v", math.Pi/4+1, value)
+	}
+	compiled := function.MustCompile()
+	if value, err := compiled(-1, 0); err != nil || math.Abs(value.Value().Real()-math.Pi) > 1e-15 {
+		t.Errorf("Expected %v, received %v, %v", math.Pi, value, err)
+	}
+	if s := function.String(); s != "Atan2(x2, x1) + Max(x1, x2)" {
+		t.Errorf("Expected Atan2(x2, x1) + Max(x1, x2), received %q", s)
+	}
+	if s, err := function.LaTeX("x", "y"); err != nil || s != `\operatorname{Atan2}\left(y, x\right) + \max\left(x, y\right)` {
+		t.Errorf("Unexpected LaTeX %q, %v", s, err)
+	}
+
+	a := args.NewVar(args.Matrix)
+	b := args.NewVar(args.Vector)
+	aug := MakeFuncPanic([]args.Var{a, b}, "Aug", "(", a, ",", b, ")")
+	result := aug.MustEval(m.NewIdentityMatrix(2), v.MakeVector(v.ColSpace, 3, 4))
+	if rows, cols := result.Matrix().Dim(); rows != 2 || cols != 3 ||
+		result.Matrix().Get(0, 2).Real() != 3 || result.Matrix().Get(1, 2).Real() != 4 {
+		t.Errorf("Unexpected augmented matrix %v", result.Matrix())
+	}
+	if _, err := aug.Eval(m.NewIdentityMatrix(2), v.MakeVector(v.ColSpace, 3, 4, 5)); err == nil {
+		t.Error("Expected error augmenting with a vector of the wrong length")
+	}
+
+	cross := MakeFuncPanic([]args.Var{b}, "Cross", "(", b, ",", v.MakeVector(v.ColSpace, 0, 1, 0), ")")
+	if result := cross.MustEval(v.MakeVector(v.ColSpace, 1, 0, 0)); result.Vector().Get(2).Real() != 1 {
+		t.Errorf("Unexpected cross product %v", result.Vector())
+	}
+}
@@ -0,0 +1,389 @@
+package functions
+
+import (
+	"errors"
+
+	args "github.com/NumberXNumbers/types/gc/functions/arguments"
+	"github.com/NumberXNumbers/types/gc/functions/ops"
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	mops "github.com/NumberXNumbers/types/gc/matrices/ops"
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	gcvops "github.com/NumberXNumbers/types/gc/values/ops"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+var (
+	one = gcv.MakeValue(1)
+	two = gcv.MakeValue(2)
+	// unaryDerivatives holds the derivative of each unary function that only supports Values
+	unaryDerivatives = map[string]func(gcv.Value) gcv.Value{
+		"Sqrt": func(a gcv.Value) gcv.Value { return gcvops.Inv(gcvops.Mult(two, gcvops.Sqrt(a))) },
+		"Sin":  gcvops.Cos,
+		"Cos":  func(a gcv.Value) gcv.Value { return gcvops.Mult(gcv.MakeValue(-1), gcvops.Sin(a)) },
+		"Tan": func(a gcv.Value) gcv.Value {
+			return gcvops.Add(one, gcvops.Pow(gcvops.Tan(a), two))
+		},
+		"Asin": func(a gcv.Value) gcv.Value {
+			return gcvops.Inv(gcvops.Sqrt(gcvops.Sub(one, gcvops.Mult(a, a))))
+		},
+		"Acos": func(a gcv.Value) gcv.Value {
+			return gcvops.Div(gcv.MakeValue(-1), gcvops.Sqrt(gcvops.Sub(one, gcvops.Mult(a, a))))
+		},
+		"Atan": func(a gcv.Value) gcv.Value { return gcvops.Inv(gcvops.Add(one, gcvops.Mult(a, a))) },
+		"Sinh": gcvops.Cosh,
+		"Cosh": gcvops.Sinh,
+		"Tanh": func(a gcv.Value) gcv.Value {
+			return gcvops.Sub(one, gcvops.Pow(gcvops.Tanh(a), two))
+		},
+		"Asinh": func(a gcv.Value) gcv.Value {
+			return gcvops.Inv(gcvops.Sqrt(gcvops.Add(gcvops.Mult(a, a), one)))
+		},
+		"Acosh": func(a gcv.Value) gcv.Value {
+			return gcvops.Inv(gcvops.Sqrt(gcvops.Sub(gcvops.Mult(a, a), one)))
+		},
+		"Atanh": func(a gcv.Value) gcv.Value { return gcvops.Inv(gcvops.Sub(one, gcvops.Mult(a, a))) },
+	}
+)
+
+// tapeNode is one recorded step of the postfix program
+type tapeNode struct {
+	value     args.Const
+	operation string
+	operands  []int
+	// variable is the index of the registered variable for a variable leaf, else -1
+	variable int
+	// needsGrad is true if the node depends on a registered variable
+	needsGrad bool
+}
+
+// record evaluates the postfix program once and returns every step in evaluation order
+func (f *Function) record(inputs ...interface{}) ([]*tapeNode, error) {
+	lenInputs := len(inputs)
+	if lenInputs != f.numVars {
+		return nil, errors.New("Number of inputs is not equal to the number of variables in function")
+	}
+
+	var tape []*tapeNode
+	var operandStack []int
+	for i := range f.Args {
+		node := &tapeNode{variable: -1}
+		if f.typeInput(i) == args.Constant || f.typeInput(i) == args.Variable {
+			variable, err := f.getVar(i)
+			if err != nil {
+				return nil, err
+			}
+			if f.typeInput(i) == args.Variable {
+				node.variable = f.varNum[variable]
+				node.needsGrad = true
+				node.value, err = variable.Eval(inputs[node.variable])
+				if err != nil {
+					return nil, err
+				}
+			} else {
+				node.value = variable.MustEval(0)
+			}
+		} else if f.typeInput(i) == args.Operation {
+			operation, err := f.getOp(i)
+			if err != nil {
+				return nil, err
+			}
+			node.operation = operation
+
+			o, ok := f.registry.lookup(operation)
+			if !ok {
+				return nil, errors.New("Operation not supported")
+			}
+			if len(operandStack) < o.arity {
+				return nil, errors.New("Not enough operands")
+			}
+			node.operands = append([]int(nil), operandStack[len(operandStack)-o.arity:]...)
+			operandStack = operandStack[:len(operandStack)-o.arity]
+			operands := make([]args.Const, len(node.operands))
+			for j, operand := range node.operands {
+				operands[j] = tape[operand].value
+			}
+			result, err := o.eval(operands...)
+			if err != nil {
+				return nil, err
+			}
+			node.value = result
+			for _, operand := range node.operands {
+				node.needsGrad = node.needsGrad || tape[operand].needsGrad
+			}
+		}
+		operandStack = append(operandStack, len(tape))
+		tape = append(tape, node)
+	}
+
+	if len(operandStack) > 1 {
+		return nil, errors.New("To many operands left over after calculation")
+	}
+	return tape, nil
+}
+
+// Grad will evaluate the function once and backpropagate through it to find the gradient
+// of the function with respect to every registered variable, in the order the variables were registered.
+// The function must evaluate to a Value. The gradient of a Vector or Matrix variable is a Const of the
+// same shape holding the partial derivative with respect to each element. For Complex inputs the
+// gradient is the complex derivative, and Conj passes the conjugate of the gradient through.
+func (f *Function) Grad(inputs ...interface{}) ([]args.Const, error) {
+	tape, err := f.record(inputs...)
+	if err != nil {
+		return nil, err
+	}
+	if len(tape) == 0 {
+		return nil, errors.New("Function has nothing to evaluate")
+	}
+
+	output := len(tape) - 1
+	if tape[output].value.Type() != args.Value {
+		return nil, errors.New("Grad is only supported for functions that evaluate to a Value")
+	}
+
+	adjoints := make([]args.Const, len(tape))
+	adjoints[output] = args.MakeConst(one)
+	grads := make([]args.Const, f.numVars)
+	for i := output; i >= 0; i-- {
+		node := tape[i]
+		if adjoints[i] == nil || !node.needsGrad {
+			continue
+		}
+		if node.variable != -1 {
+			grads[node.variable], err = accumulate(grads[node.variable], adjoints[i])
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		operands := make([]args.Const, len(node.operands))
+		for index, operand := range node.operands {
+			operands[index] = tape[operand].value
+		}
+		operandAdjoints, err := backward(node, operands, adjoints[i])
+		if err != nil {
+			return nil, err
+		}
+		for index, operand := range node.operands {
+			if !tape[operand].needsGrad {
+				continue
+			}
+			adjoints[operand], err = accumulate(adjoints[operand], operandAdjoints[index])
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for index, grad := range grads {
+		if grad == nil {
+			grads[index] = zeroLike(args.MakeConst(inputs[index]))
+		}
+	}
+	return grads, nil
+}
+
+// MustGrad is the same as Grad but will panic
+func (f *Function) MustGrad(inputs ...interface{}) []args.Const {
+	grads, err := f.Grad(inputs...)
+	if err != nil {
+		panic(err)
+	}
+	return grads
+}
+
+// backward returns the adjoint of each operand of node given the adjoint of node
+func backward(node *tapeNode, operands []args.Const, adjoint args.Const) ([]args.Const, error) {
+	if node.operation == "Conj" {
+		conj, err := ops.Conj(adjoint)
+		return []args.Const{conj}, err
+	}
+	if derivative, ok := unaryDerivatives[node.operation]; ok {
+		return []args.Const{args.MakeConst(gcvops.Mult(adjoint.Value(), derivative(operands[0].Value())))}, nil
+	}
+
+	if node.operation == neg {
+		return []args.Const{scale(gcv.MakeValue(-1), adjoint)}, nil
+	}
+	if len(operands) != 2 {
+		return nil, errors.New("Grad is not supported for operation " + node.operation)
+	}
+	a, b := operands[0], operands[1]
+	switch node.operation {
+	case "+":
+		return []args.Const{adjoint, adjoint}, nil
+	case "-":
+		return []args.Const{adjoint, scale(gcv.MakeValue(-1), adjoint)}, nil
+	case "*":
+		return multBackward(a, b, adjoint)
+	case "/":
+		// a/b is a scaled by 1/b, for Values, Vectors and Matrices
+		inverse := gcvops.Inv(b.Value())
+		gradB := gcvops.Mult(gcv.MakeValue(-1), gcvops.Mult(dot(adjoint, a), gcvops.Mult(inverse, inverse)))
+		return []args.Const{scale(inverse, adjoint), args.MakeConst(gradB)}, nil
+	case pow:
+		return powBackward(node, a, b, adjoint)
+	}
+	return nil, errors.New("Grad is not supported for operation " + node.operation)
+}
+
+func multBackward(a args.Const, b args.Const, adjoint args.Const) ([]args.Const, error) {
+	switch {
+	case a.Type() == args.Value:
+		return []args.Const{args.MakeConst(dot(adjoint, b)), scale(a.Value(), adjoint)}, nil
+	case b.Type() == args.Value:
+		return []args.Const{scale(b.Value(), adjoint), args.MakeConst(dot(adjoint, a))}, nil
+	case a.Type() == args.Vector && b.Type() == args.Vector:
+		vectorA, vectorB := a.Vector(), b.Vector()
+		if vectorA.Space() == v.RowSpace {
+			// inner product
+			gradA := scale(adjoint.Value(), args.MakeConst(vectorB))
+			gradB := scale(adjoint.Value(), args.MakeConst(vectorA))
+			return []args.Const{inSpace(gradA, vectorA.Space()), inSpace(gradB, vectorB.Space())}, nil
+		}
+		// outer product
+		matrix := adjoint.Matrix()
+		gradA := matVec(matrix, vectorB, false, vectorA.Space())
+		gradB := matVec(matrix, vectorA, true, vectorB.Space())
+		return []args.Const{args.MakeConst(gradA), args.MakeConst(gradB)}, nil
+	case a.Type() == args.Vector && b.Type() == args.Matrix:
+		vector, matrix := a.Vector(), b.Matrix()
+		gradA := matVec(matrix, adjoint.Vector(), false, vector.Space())
+		return []args.Const{args.MakeConst(gradA), args.MakeConst(outer(vector, adjoint.Vector()))}, nil
+	case a.Type() == args.Matrix && b.Type() == args.Vector:
+		matrix, vector := a.Matrix(), b.Vector()
+		gradB := matVec(matrix, adjoint.Vector(), true, vector.Space())
+		return []args.Const{args.MakeConst(outer(adjoint.Vector(), vector)), args.MakeConst(gradB)}, nil
+	}
+	matrixA, matrixB, matrixG := a.Matrix(), b.Matrix(), adjoint.Matrix()
+	gradA, err := mops.MultSimple(matrixG, m.MakeTransMatrix(matrixB))
+	if err != nil {
+		return nil, err
+	}
+	gradB, err := mops.MultSimple(m.MakeTransMatrix(matrixA), matrixG)
+	if err != nil {
+		return nil, err
+	}
+	return []args.Const{args.MakeConst(gradA), args.MakeConst(gradB)}, nil
+}
+
+func powBackward(node *tapeNode, a args.Const, b args.Const, adjoint args.Const) ([]args.Const, error) {
+	if a.Type() == args.Value {
+		base, exponent := a.Value(), b.Value()
+		gradA := gcvops.Mult(adjoint.Value(), gcvops.Mult(exponent, gcvops.Pow(base, gcvops.Sub(exponent, one))))
+		gradB := gcvops.Mult(adjoint.Value(), gcvops.Mult(node.value.Value(), gcvops.Log(base)))
+		return []args.Const{args.MakeConst(gradA), args.MakeConst(gradB)}, nil
+	}
+
+	// d(A^n) = sum over k of A^k dA A^(n-1-k), so the adjoint of A is sum over k of (A^T)^k G (A^T)^(n-1-k)
+	n := int(b.Value().Real())
+	if n < 0 {
+		return nil, errors.New("Grad is not supported for negative Matrix powers")
+	}
+	trans := m.MakeTransMatrix(a.Matrix())
+	powers := []m.Matrix{m.NewIdentityMatrix(trans.GetNumRows())}
+	for k := 1; k < n; k++ {
+		powers = append(powers, mops.MustMultSimple(powers[k-1], trans))
+	}
+	gradA := args.MakeConst(m.NewMatrix(trans.GetNumRows(), trans.GetNumCols()))
+	for k := 0; k < n; k++ {
+		term := mops.MustMultSimple(mops.MustMultSimple(powers[k], adjoint.Matrix()), powers[n-1-k])
+		gradA = ops.MustAdd(gradA, args.MakeConst(term))
+	}
+	// the integer power of a Matrix is not differentiable in the power
+	return []args.Const{gradA, args.MakeConst(gcv.Zero())}, nil
+}
+
+// accumulate returns sum + adjoint, where a nil sum is zero
+func accumulate(sum args.Const, adjoint args.Const) (args.Const, error) {
+	if sum == nil {
+		return adjoint, nil
+	}
+	return ops.Add(sum, adjoint)
+}
+
+// scale returns the Const c multiplied by the Value s
+func scale(s gcv.Value, c args.Const) args.Const {
+	return ops.MustMult(args.MakeConst(s), c)
+}
+
+// elementsOf returns the Values of a Const, in row major order for Matrices
+func elementsOf(c args.Const) []gcv.Value {
+	switch c.Type() {
+	case args.Vector:
+		vector := c.Vector()
+		elements := make([]gcv.Value, vector.Len())
+		for i := range elements {
+			elements[i] = vector.Get(i)
+		}
+		return elements
+	case args.Matrix:
+		matrix := c.Matrix()
+		rows, cols := matrix.Dim()
+		elements := make([]gcv.Value, 0, rows*cols)
+		for i := 0; i < rows; i++ {
+			for j := 0; j < cols; j++ {
+				elements = append(elements, matrix.Get(i, j))
+			}
+		}
+		return elements
+	}
+	return []gcv.Value{c.Value()}
+}
+
+// dot returns the sum of the element wise product of two Consts of the same shape
+func dot(a args.Const, b args.Const) gcv.Value {
+	elementsA, elementsB := elementsOf(a), elementsOf(b)
+	sum := gcv.Zero()
+	for i := range elementsA {
+		sum = gcvops.Add(sum, gcvops.Mult(elementsA[i], elementsB[i]))
+	}
+	return sum
+}
+
+// inSpace returns the Vector c in space
+func inSpace(c args.Const, space v.Space) args.Const {
+	vector := c.Vector()
+	if vector.Space() != space {
+		vector = v.MakeTransVector(vector)
+	}
+	return args.MakeConst(vector)
+}
+
+// matVec returns matrix*vector, or matrix^T*vector if trans is true, as a Vector in space
+func matVec(matrix m.Matrix, vector v.Vector, trans bool, space v.Space) v.Vector {
+	if trans {
+		matrix = m.MakeTransMatrix(matrix)
+	}
+	result := v.NewVector(space, matrix.GetNumRows())
+	for i := 0; i < matrix.GetNumRows(); i++ {
+		sum := gcv.Zero()
+		for j := 0; j < matrix.GetNumCols(); j++ {
+			sum = gcvops.Add(sum, gcvops.Mult(matrix.Get(i, j), vector.Get(j)))
+		}
+		result.Set(i, sum)
+	}
+	return result
+}
+
+// outer returns the Matrix with elements vectorA_i*vectorB_j regardless of the Space of either Vector
+func outer(vectorA v.Vector, vectorB v.Vector) m.Matrix {
+	matrix := m.NewMatrix(vectorA.Len(), vectorB.Len())
+	for i := 0; i < vectorA.Len(); i++ {
+		for j := 0; j < vectorB.Len(); j++ {
+			matrix.Set(i, j, gcvops.Mult(vectorA.Get(i), vectorB.Get(j)))
+		}
+	}
+	return matrix
+}
+
+// zeroLike returns the zero Const of the same shape as c
+func zeroLike(c args.Const) args.Const {
+	switch c.Type() {
+	case args.Vector:
+		return args.MakeConst(v.NewVector(c.Vector().Space(), c.Vector().Len()))
+	case args.Matrix:
+		return args.MakeConst(m.NewMatrix(c.Matrix().GetNumRows(), c.Matrix().GetNumCols()))
+	}
+	return args.MakeConst(gcv.Zero())
+}
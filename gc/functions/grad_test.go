@@ -0,0 +1,166 @@
+package functions
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	args "github.com/NumberXNumbers/types/gc/functions/arguments"
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+// perturb returns a copy of input with element moved by step
+func perturb(input interface{}, element int, step float64) interface{} {
+	switch input.(type) {
+	case v.Vector:
+		vector := input.(v.Vector).Copy()
+		vector.Set(element, gcv.MakeValue(vector.Get(element).Real()+step))
+		return vector
+	case m.Matrix:
+		matrix := input.(m.Matrix).Copy()
+		cols := matrix.GetNumCols()
+		matrix.Set(element/cols, element%cols, gcv.MakeValue(matrix.Get(element/cols, element%cols).Real()+step))
+		return matrix
+	}
+	return input.(float64) + step
+}
+
+// checkGrad compares the gradient of function against central differences
+func checkGrad(t *testing.T, function *Function, inputs ...interface{}) {
+	grads, err := function.Grad(inputs...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	step := 1e-6
+	for index, grad := range grads {
+		for element, partial := range elementsOf(grad) {
+			plus := append([]interface{}(nil), inputs...)
+			minus := append([]interface{}(nil), inputs...)
+			plus[index] = perturb(inputs[index], element, step)
+			minus[index] = perturb(inputs[index], element, -step)
+			numeric := (function.MustEval(plus...).Value().Real() - function.MustEval(minus...).Value().Real()) / (2 * step)
+			if math.Abs(partial.Real()-numeric) > 1e-6*math.Max(1, math.Abs(numeric)) {
+				t.Errorf("Variable %d element %d: Expected %v, received %v", index, element, numeric, partial.Real())
+			}
+		}
+	}
+}
+
+func TestGradValues(t *testing.T) {
+	x := args.NewVar(args.Value)
+	y := args.NewVar(args.Value)
+	regVars := []args.Var{x, y}
+	function := MakeFuncPanic(regVars, x, "*", y, "+", "Sin", "(", x, ")", "-", x, "^", args.MakeConst(2), "/", y)
+	checkGrad(t, function, 0.7, 1.3)
+
+	grads := function.MustGrad(0.7, 1.3)
+	solution := 1.3 + math.Cos(0.7) - 2*0.7/1.3
+	if math.Abs(grads[0].Value().Real()-solution) > 1e-15 {
+		t.Errorf("Expected %v, received %v", solution, grads[0].Value().Real())
+	}
+
+	function = MakeFuncPanic(regVars, x, "^", y)
+	checkGrad(t, function, 1.5, 2.5)
+}
+
+func TestGradUnaryFunctions(t *testing.T) {
+	x := args.NewVar(args.Value)
+	regVars := []args.Var{x}
+	for operation := range unaryDerivatives {
+		input := 0.5
+		if operation == "Acosh" {
+			input = 1.5
+		}
+		function := MakeFuncPanic(regVars, operation, "(", x, "*", x, ")")
+		checkGrad(t, function, input)
+	}
+
+	function := MakeFuncPanic(regVars, "Conj", "(", x, ")", "*", x)
+	checkGrad(t, function, 3.0)
+}
+
+func TestGradVectorsMatrices(t *testing.T) {
+	a := args.NewVar(args.Vector)
+	b := args.NewVar(args.Vector)
+	x := args.NewVar(args.Matrix)
+	regVars := []args.Var{a, x, b}
+	vectorA := v.MakeVector(v.RowSpace, 1, -2, 3)
+	vectorB := v.MakeVector(v.ColSpace, 0.5, 2, -1)
+	matrix := m.MakeMatrix(
+		v.MakeVector(v.RowSpace, 2, 1, 0),
+		v.MakeVector(v.RowSpace, -1, 3, 1),
+		v.MakeVector(v.RowSpace, 0, 1, 4))
+
+	// a*(X*b) and (a*X)*b
+	function := MakeFuncPanic(regVars, a, "*", "(", x, "*", b, ")")
+	checkGrad(t, function, vectorA, matrix, vectorB)
+	function = MakeFuncPanic(regVars, "(", a, "*", x, ")", "*", b)
+	checkGrad(t, function, vectorA, matrix, vectorB)
+
+	grads := function.MustGrad(vectorA, matrix, vectorB)
+	if grads[0].Type() != args.Vector || grads[0].Vector().Space() != v.RowSpace ||
+		grads[1].Type() != args.Matrix || grads[1].Matrix().GetNumRows() != 3 ||
+		grads[2].Type() != args.Vector || grads[2].Vector().Space() != v.ColSpace {
+		t.Errorf("Expected gradients of shape %v, received %v", []args.Type{args.Vector, args.Matrix, args.Vector}, grads)
+	}
+
+	// a*(X*X*X - X*2)*b/2 with a matrix product and power
+	function = MakeFuncPanic(regVars, a, "*", "(", x, "*", x, "^", args.MakeConst(2), "-", x, "*", args.MakeConst(2), ")", "*", b, "/", args.MakeConst(2))
+	checkGrad(t, function, vectorA, matrix, vectorB)
+
+	// (b*a) is an outer product, a*(a*b)*b scales a vector
+	function = MakeFuncPanic(regVars, a, "*", "(", b, "*", a, ")", "*", b, "+", "(", a, "*", b, ")", "*", "(", a, "*", b, ")")
+	checkGrad(t, function, vectorA, matrix, vectorB)
+}
+
+func TestGradUnusedVariable(t *testing.T) {
+	x := args.NewVar(args.Value)
+	y := args.NewVar(args.Matrix)
+	regVars := []args.Var{x, y}
+	function := MakeFuncPanic(regVars, x, "*", args.MakeConst(3))
+	grads := function.MustGrad(2, m.NewMatrix(2, 3))
+	if grads[0].Value().Real() != 3 {
+		t.Errorf("Expected %v, received %v", 3, grads[0].Value())
+	}
+	if grads[1].Matrix().GetNumRows() != 2 || grads[1].Matrix().GetNumCols() != 3 {
+		t.Errorf("Expected %v, received %v", "2x3 zero Matrix", grads[1])
+	}
+}
+
+func TestGradErrors(t *testing.T) {
+	x := args.NewVar(args.Vector)
+	regVars := []args.Var{x}
+	function := MakeFuncPanic(regVars, x, "*", args.MakeConst(2))
+	if _, err := function.Grad(v.MakeVector(v.RowSpace, 1, 2)); err == nil {
+		t.Error("Expected error for a Vector valued function")
+	}
+
+	if _, err := function.Grad(); err == nil {
+		t.Error("Expected error for missing inputs")
+	}
+
+	if _, err := function.Grad(2); err == nil {
+		t.Error("Expected error for input of the wrong type")
+	}
+
+	y := args.NewVar(args.Matrix)
+	function = MakeFuncPanic([]args.Var{y}, args.MakeConst(v.MakeVector(v.RowSpace, 1, 1)), "*", y, "^", args.MakeConst(-1), "*", args.MakeConst(v.MakeVector(v.ColSpace, 1, 1)))
+	if _, err := function.Grad(m.NewIdentityMatrix(2)); err == nil {
+		t.Error("Expected error for a negative Matrix power")
+	}
+}
+
+func TestMustGrad(t *testing.T) {
+	x := args.NewVar(args.Value)
+	function := MakeFuncPanic([]args.Var{x}, x)
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Printf("Recovered from %v error\n", r)
+		}
+	}()
+
+	function.MustGrad()
+	t.Errorf("Expected panic from MustGrad")
+}
@@ -6,17 +6,44 @@ import (
 	args "github.com/NumberXNumbers/types/gc/functions/arguments"
 	m "github.com/NumberXNumbers/types/gc/matrices"
 	mops "github.com/NumberXNumbers/types/gc/matrices/ops"
+	gcv "github.com/NumberXNumbers/types/gc/values"
 	gcvops "github.com/NumberXNumbers/types/gc/values/ops"
 	v "github.com/NumberXNumbers/types/gc/vectors"
 	vops "github.com/NumberXNumbers/types/gc/vectors/ops"
 )
 
+// valueConst returns the Const of value, or err if it is not nil
+func valueConst(value gcv.Value, err error) (args.Const, error) {
+	if err != nil {
+		return nil, err
+	}
+	return args.MakeConst(value), nil
+}
+
+// binaryValue returns f of valueA and valueB. If either is a Quantity, quantity is used instead
+// so that Values of the wrong Dimension return error rather than NaN
+func binaryValue(valueA gcv.Value, valueB gcv.Value, f func(gcv.Value, gcv.Value) gcv.Value,
+	quantity func(gcv.Value, gcv.Value) (gcv.Value, error)) (args.Const, error) {
+	if valueA.Type() == gcv.Quantity || valueB.Type() == gcv.Quantity {
+		return valueConst(quantity(valueA, valueB))
+	}
+	return args.MakeConst(f(valueA, valueB)), nil
+}
+
+// dimensionless returns f of value. A Quantity must be dimensionless, else error is returned
+func dimensionless(value gcv.Value, f func(gcv.Value) gcv.Value) (args.Const, error) {
+	if value.Type() == gcv.Quantity {
+		return valueConst(gcvops.ApplyDimensionless(f, value))
+	}
+	return args.MakeConst(f(value)), nil
+}
+
 // Add will add two constants together
 // For vectors, the two vectors need to be in the same space and size, else error.
 // For matrices, the two matrices need to be of the same size, else error.
 func Add(constA args.Const, constB args.Const) (args.Const, error) {
 	if constA.Type() == args.Value && constB.Type() == args.Value {
-		return args.MakeConst(gcvops.Add(constA.Value(), constB.Value())), nil
+		return binaryValue(constA.Value(), constB.Value(), gcvops.Add, gcvops.AddQuantity)
 	}
 
 	if constA.Type() == args.Vector && constB.Type() == args.Vector {
@@ -49,7 +76,7 @@ func MustAdd(constA args.Const, constB args.Const) args.Const {
 // Sub will subtract two constants together
 func Sub(constA args.Const, constB args.Const) (args.Const, error) {
 	if constA.Type() == args.Value && constB.Type() == args.Value {
-		return args.MakeConst(gcvops.Sub(constA.Value(), constB.Value())), nil
+		return binaryValue(constA.Value(), constB.Value(), gcvops.Sub, gcvops.SubQuantity)
 	}
 	if constA.Type() == args.Vector && constB.Type() == args.Vector {
 		vector, err := vops.Sub(constA.Vector(), constB.Vector())
@@ -167,7 +194,7 @@ func MustMult(constA args.Const, constB args.Const) args.Const {
 // for matrix consts, it is assumed that the value it will be raised to is an integer
 func Pow(constA args.Const, constB args.Const) (args.Const, error) {
 	if constA.Type() == args.Value && constB.Type() == args.Value {
-		return args.MakeConst(gcvops.Pow(constA.Value(), constB.Value())), nil
+		return binaryValue(constA.Value(), constB.Value(), gcvops.Pow, gcvops.PowQuantity)
 	}
 	if constA.Type() == args.Matrix && constB.Type() == args.Value {
 		matrix, err := mops.Pow(constA.Matrix(), int(constB.Value().Real()))
@@ -188,9 +215,35 @@ func MustPow(constA args.Const, constB args.Const) args.Const {
 	return con
 }
 
+// Neg will find the negative of a Const
+func Neg(constant args.Const) (args.Const, error) {
+	minusOne := gcv.MakeValue(-1)
+	switch constant.Type() {
+	case args.Value:
+		return args.MakeConst(gcvops.Mult(minusOne, constant.Value())), nil
+	case args.Vector:
+		return args.MakeConst(vops.SMult(minusOne, constant.Vector())), nil
+	case args.Matrix:
+		return args.MakeConst(mops.SMult(minusOne, constant.Matrix())), nil
+	}
+	return nil, errors.New("Const Type is not supported for Neg")
+}
+
+// MustNeg is the same as Neg but will panic
+func MustNeg(constant args.Const) args.Const {
+	con, err := Neg(constant)
+	if err != nil {
+		panic(err)
+	}
+	return con
+}
+
 // Sqrt will find the square root of a Const
 func Sqrt(constant args.Const) (args.Const, error) {
 	if constant.Type() == args.Value {
+		if constant.Value().Type() == gcv.Quantity {
+			return valueConst(gcvops.PowQuantity(constant.Value(), gcv.MakeValue(0.5)))
+		}
 		return args.MakeConst(gcvops.Sqrt(constant.Value())), nil
 	}
 	return nil, errors.New("Const Type is not supported for Sqrt")
@@ -231,7 +284,7 @@ func MustConj(constant args.Const) args.Const {
 // Sin will find the sine of a Const
 func Sin(constant args.Const) (args.Const, error) {
 	if constant.Type() == args.Value {
-		return args.MakeConst(gcvops.Sin(constant.Value())), nil
+		return dimensionless(constant.Value(), gcvops.Sin)
 	}
 	return nil, errors.New("Const Type is not supported for Sin")
 }
@@ -248,7 +301,7 @@ func MustSin(constant args.Const) args.Const {
 // Cos will find the cosine of a Const
 func Cos(constant args.Const) (args.Const, error) {
 	if constant.Type() == args.Value {
-		return args.MakeConst(gcvops.Cos(constant.Value())), nil
+		return dimensionless(constant.Value(), gcvops.Cos)
 	}
 	return nil, errors.New("Const Type is not supported for Cos")
 }
@@ -265,7 +318,7 @@ func MustCos(constant args.Const) args.Const {
 // Tan will find the tangent of a Const
 func Tan(constant args.Const) (args.Const, error) {
 	if constant.Type() == args.Value {
-		return args.MakeConst(gcvops.Tan(constant.Value())), nil
+		return dimensionless(constant.Value(), gcvops.Tan)
 	}
 	return nil, errors.New("Const Type is not supported for Tan")
 }
@@ -282,7 +335,7 @@ func MustTan(constant args.Const) args.Const {
 // Asin will find the arcsine of a Const
 func Asin(constant args.Const) (args.Const, error) {
 	if constant.Type() == args.Value {
-		return args.MakeConst(gcvops.Asin(constant.Value())), nil
+		return dimensionless(constant.Value(), gcvops.Asin)
 	}
 	return nil, errors.New("Const Type is not supported for Asin")
 }
@@ -299,7 +352,7 @@ func MustAsin(constant args.Const) args.Const {
 // Acos will find the arccosine of a Const
 func Acos(constant args.Const) (args.Const, error) {
 	if constant.Type() == args.Value {
-		return args.MakeConst(gcvops.Acos(constant.Value())), nil
+		return dimensionless(constant.Value(), gcvops.Acos)
 	}
 	return nil, errors.New("Const Type is not supported for Acos")
 }
@@ -316,7 +369,7 @@ func MustAcos(constant args.Const) args.Const {
 // Atan will find the arctangent of a Const
 func Atan(constant args.Const) (args.Const, error) {
 	if constant.Type() == args.Value {
-		return args.MakeConst(gcvops.Atan(constant.Value())), nil
+		return dimensionless(constant.Value(), gcvops.Atan)
 	}
 	return nil, errors.New("Const Type is not supported for Atan")
 }
@@ -333,7 +386,7 @@ func MustAtan(constant args.Const) args.Const {
 // Sinh will find the hyperbolicSine of a Const
 func Sinh(constant args.Const) (args.Const, error) {
 	if constant.Type() == args.Value {
-		return args.MakeConst(gcvops.Sinh(constant.Value())), nil
+		return dimensionless(constant.Value(), gcvops.Sinh)
 	}
 	return nil, errors.New("Const Type is not supported for Sinh")
 }
@@ -350,7 +403,7 @@ func MustSinh(constant args.Const) args.Const {
 // Cosh will find the hyperbolicCosine of a Const
 func Cosh(constant args.Const) (args.Const, error) {
 	if constant.Type() == args.Value {
-		return args.MakeConst(gcvops.Cosh(constant.Value())), nil
+		return dimensionless(constant.Value(), gcvops.Cosh)
 	}
 	return nil, errors.New("Const Type is not supported for Cosh")
 }
@@ -367,7 +420,7 @@ func MustCosh(constant args.Const) args.Const {
 // Tanh will find the hyperbolicTangent of a Const
 func Tanh(constant args.Const) (args.Const, error) {
 	if constant.Type() == args.Value {
-		return args.MakeConst(gcvops.Tanh(constant.Value())), nil
+		return dimensionless(constant.Value(), gcvops.Tanh)
 	}
 	return nil, errors.New("Const Type is not supported for Tanh")
 }
@@ -384,7 +437,7 @@ func MustTanh(constant args.Const) args.Const {
 // Asinh will find the hyperbolicArcSine of a Const
 func Asinh(constant args.Const) (args.Const, error) {
 	if constant.Type() == args.Value {
-		return args.MakeConst(gcvops.Asinh(constant.Value())), nil
+		return dimensionless(constant.Value(), gcvops.Asinh)
 	}
 	return nil, errors.New("Const Type is not supported for Asinh")
 }
@@ -401,7 +454,7 @@ func MustAsinh(constant args.Const) args.Const {
 // Acosh will find the hyperbolicArcCosine of a Const
 func Acosh(constant args.Const) (args.Const, error) {
 	if constant.Type() == args.Value {
-		return args.MakeConst(gcvops.Acosh(constant.Value())), nil
+		return dimensionless(constant.Value(), gcvops.Acosh)
 	}
 	return nil, errors.New("Const Type is not supported for Acosh")
 }
@@ -418,7 +471,7 @@ func MustAcosh(constant args.Const) args.Const {
 // Atanh will find the hyperbolicArcTangent of a Const
 func Atanh(constant args.Const) (args.Const, error) {
 	if constant.Type() == args.Value {
-		return args.MakeConst(gcvops.Atanh(constant.Value())), nil
+		return dimensionless(constant.Value(), gcvops.Atanh)
 	}
 	return nil, errors.New("Const Type is not supported for Atanh")
 }
@@ -431,3 +484,221 @@ func MustAtanh(constant args.Const) args.Const {
 	}
 	return con
 }
+
+// values returns the Values of constA and constB, or error if either is not a Value
+func values(name string, constA args.Const, constB args.Const) (gcv.Value, gcv.Value, error) {
+	if constA.Type() != args.Value || constB.Type() != args.Value {
+		return nil, nil, errors.New("Const Type is not supported for " + name)
+	}
+	return constA.Value(), constB.Value(), nil
+}
+
+// Atan2 will find the arc tangent of constA/constB, using the signs of both to find the quadrant
+func Atan2(constA args.Const, constB args.Const) (args.Const, error) {
+	valueA, valueB, err := values("Atan2", constA, constB)
+	if err != nil {
+		return nil, err
+	}
+	value, err := gcvops.Atan2(valueA, valueB)
+	if err != nil {
+		return nil, err
+	}
+	return args.MakeConst(value), nil
+}
+
+// MustAtan2 is the same as Atan2 but will panic
+func MustAtan2(constA args.Const, constB args.Const) args.Const {
+	con, err := Atan2(constA, constB)
+	if err != nil {
+		panic(err)
+	}
+	return con
+}
+
+// LogBase will find the log of constA in the base constB
+func LogBase(constA args.Const, constB args.Const) (args.Const, error) {
+	valueA, valueB, err := values("LogBase", constA, constB)
+	if err != nil {
+		return nil, err
+	}
+	return binaryValue(valueA, valueB, gcvops.LogBase, func(valueA gcv.Value, valueB gcv.Value) (gcv.Value, error) {
+		base, err := gcvops.ApplyDimensionless(func(b gcv.Value) gcv.Value { return b }, valueB)
+		if err != nil {
+			return nil, err
+		}
+		return gcvops.ApplyDimensionless(func(a gcv.Value) gcv.Value { return gcvops.LogBase(a, base) }, valueA)
+	})
+}
+
+// MustLogBase is the same as LogBase but will panic
+func MustLogBase(constA args.Const, constB args.Const) args.Const {
+	con, err := LogBase(constA, constB)
+	if err != nil {
+		panic(err)
+	}
+	return con
+}
+
+// Max will find the larger of two Real Consts
+func Max(constA args.Const, constB args.Const) (args.Const, error) {
+	valueA, valueB, err := values("Max", constA, constB)
+	if err != nil {
+		return nil, err
+	}
+	value, err := gcvops.Max(valueA, valueB)
+	if err != nil {
+		return nil, err
+	}
+	return args.MakeConst(value), nil
+}
+
+// MustMax is the same as Max but will panic
+func MustMax(constA args.Const, constB args.Const) args.Const {
+	con, err := Max(constA, constB)
+	if err != nil {
+		panic(err)
+	}
+	return con
+}
+
+// Min will find the smaller of two Real Consts
+func Min(constA args.Const, constB args.Const) (args.Const, error) {
+	valueA, valueB, err := values("Min", constA, constB)
+	if err != nil {
+		return nil, err
+	}
+	value, err := gcvops.Min(valueA, valueB)
+	if err != nil {
+		return nil, err
+	}
+	return args.MakeConst(value), nil
+}
+
+// MustMin is the same as Min but will panic
+func MustMin(constA args.Const, constB args.Const) args.Const {
+	con, err := Min(constA, constB)
+	if err != nil {
+		panic(err)
+	}
+	return con
+}
+
+// Mod will find the modulo of the Real Const constA by the Real Const constB
+func Mod(constA args.Const, constB args.Const) (args.Const, error) {
+	valueA, valueB, err := values("Mod", constA, constB)
+	if err != nil {
+		return nil, err
+	}
+	value, err := gcvops.Mod(valueA, valueB)
+	if err != nil {
+		return nil, err
+	}
+	return args.MakeConst(value), nil
+}
+
+// MustMod is the same as Mod but will panic
+func MustMod(constA args.Const, constB args.Const) args.Const {
+	con, err := Mod(constA, constB)
+	if err != nil {
+		panic(err)
+	}
+	return con
+}
+
+// Aug will augment the Matrix constA with the columns of the Matrix or Column Vector constB.
+// constB must have as many rows as constA, else error.
+func Aug(constA args.Const, constB args.Const) (args.Const, error) {
+	if constA.Type() != args.Matrix {
+		return nil, errors.New("Const Type is not supported for Aug")
+	}
+	matrix := constA.Matrix()
+	switch constB.Type() {
+	case args.Matrix:
+		if constB.Matrix().GetNumRows() != matrix.GetNumRows() {
+			return nil, errors.New("Number of rows of matrices are not equal")
+		}
+		return args.MakeConst(matrix.Aug(constB.Matrix())), nil
+	case args.Vector:
+		vector := constB.Vector()
+		if vector.Space() != v.ColSpace || vector.Len() != matrix.GetNumRows() {
+			return nil, errors.New("Vector is not a column vector of the number of rows of matrix")
+		}
+		return args.MakeConst(matrix.Aug(vector)), nil
+	}
+	return nil, errors.New("Const Type is not supported for Aug")
+}
+
+// MustAug is the same as Aug but will panic
+func MustAug(constA args.Const, constB args.Const) args.Const {
+	con, err := Aug(constA, constB)
+	if err != nil {
+		panic(err)
+	}
+	return con
+}
+
+// Kron will find the Kronecker product of two Vectors or two Matrices
+func Kron(constA args.Const, constB args.Const) (args.Const, error) {
+	if constA.Type() == args.Vector && constB.Type() == args.Vector {
+		vector, err := vops.Kron(constA.Vector(), constB.Vector())
+		if err != nil {
+			return nil, err
+		}
+		return args.MakeConst(vector), nil
+	}
+	if constA.Type() == args.Matrix && constB.Type() == args.Matrix {
+		return args.MakeConst(mops.Kron(constA.Matrix(), constB.Matrix())), nil
+	}
+	return nil, errors.New("One or More Types are not supported")
+}
+
+// MustKron is the same as Kron but will panic
+func MustKron(constA args.Const, constB args.Const) args.Const {
+	con, err := Kron(constA, constB)
+	if err != nil {
+		panic(err)
+	}
+	return con
+}
+
+// Hadamard will find the elementwise product of two Matrices of the same size
+func Hadamard(constA args.Const, constB args.Const) (args.Const, error) {
+	if constA.Type() != args.Matrix || constB.Type() != args.Matrix {
+		return nil, errors.New("Const Type is not supported for Hadamard")
+	}
+	matrix, err := mops.Hadamard(constA.Matrix(), constB.Matrix())
+	if err != nil {
+		return nil, err
+	}
+	return args.MakeConst(matrix), nil
+}
+
+// MustHadamard is the same as Hadamard but will panic
+func MustHadamard(constA args.Const, constB args.Const) args.Const {
+	con, err := Hadamard(constA, constB)
+	if err != nil {
+		panic(err)
+	}
+	return con
+}
+
+// Cross will find the cross product of two 3 dimensional Vectors in the same space
+func Cross(constA args.Const, constB args.Const) (args.Const, error) {
+	if constA.Type() != args.Vector || constB.Type() != args.Vector {
+		return nil, errors.New("Const Type is not supported for Cross")
+	}
+	vector, err := vops.Cross(constA.Vector(), constB.Vector())
+	if err != nil {
+		return nil, err
+	}
+	return args.MakeConst(vector), nil
+}
+
+// MustCross is the same as Cross but will panic
+func MustCross(constA args.Const, constB args.Const) args.Const {
+	con, err := Cross(constA, constB)
+	if err != nil {
+		panic(err)
+	}
+	return con
+}
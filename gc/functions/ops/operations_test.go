@@ -712,3 +712,147 @@ func TestPanicBadAsinh(t *testing.T) {
 		t.Error("Expected Panic")
 	}
 }
+
+func TestAtan2(t *testing.T) {
+	solution := MustAtan2(args.MakeConst(1), args.MakeConst(-1))
+	if math.Abs(solution.Value().Real()-3*math.Pi/4) > 1e-15 {
+		t.Errorf("Expected %v, received %v", 3*math.Pi/4, solution.Value())
+	}
+
+	if _, err := Atan2(args.MakeConst(1i), args.MakeConst(1)); err == nil {
+		t.Error("Expected error for Complex Values")
+	}
+	if _, err := Atan2(args.MakeConst(v.NewVector(v.RowSpace, 2)), args.MakeConst(1)); err == nil {
+		t.Error("Expected error for Vector")
+	}
+}
+
+func TestLogBase(t *testing.T) {
+	solution := MustLogBase(args.MakeConst(8), args.MakeConst(2))
+	if math.Abs(solution.Value().Real()-3) > 1e-15 {
+		t.Errorf("Expected 3, received %v", solution.Value())
+	}
+
+	if _, err := LogBase(args.MakeConst(m.NewIdentityMatrix(2)), args.MakeConst(2)); err == nil {
+		t.Error("Expected error for Matrix")
+	}
+}
+
+func TestMaxMinMod(t *testing.T) {
+	if solution := MustMax(args.MakeConst(2), args.MakeConst(-3)); solution.Value().Real() != 2 {
+		t.Errorf("Expected 2, received %v", solution.Value())
+	}
+	if solution := MustMin(args.MakeConst(2), args.MakeConst(-3)); solution.Value().Real() != -3 {
+		t.Errorf("Expected -3, received %v", solution.Value())
+	}
+	if solution := MustMod(args.MakeConst(7), args.MakeConst(3)); solution.Value().Real() != 1 {
+		t.Errorf("Expected 1, received %v", solution.Value())
+	}
+
+	if _, err := Max(args.MakeConst(1i), args.MakeConst(1)); err == nil {
+		t.Error("Expected error for Complex Values")
+	}
+	if _, err := Min(args.MakeConst(v.NewVector(v.RowSpace, 2)), args.MakeConst(1)); err == nil {
+		t.Error("Expected error for Vector")
+	}
+	if _, err := Mod(args.MakeConst(1i), args.MakeConst(1)); err == nil {
+		t.Error("Expected error for Complex Values")
+	}
+}
+
+func TestAug(t *testing.T) {
+	solutionA := MustAug(args.MakeConst(m.NewIdentityMatrix(2)), args.MakeConst(v.MakeVector(v.ColSpace, 5, 6)))
+	if rows, cols := solutionA.Matrix().Dim(); rows != 2 || cols != 3 ||
+		solutionA.Matrix().Get(0, 2).Complex() != 5 ||
+		solutionA.Matrix().Get(1, 2).Complex() != 6 {
+		t.Errorf("Unexpected augmented matrix %v", solutionA.Matrix())
+	}
+
+	solutionB := MustAug(args.MakeConst(m.NewIdentityMatrix(2)), args.MakeConst(m.NewIdentityMatrix(2)))
+	if rows, cols := solutionB.Matrix().Dim(); rows != 2 || cols != 4 ||
+		solutionB.Matrix().Get(1, 3).Complex() != 1 {
+		t.Errorf("Unexpected augmented matrix %v", solutionB.Matrix())
+	}
+
+	if _, err := Aug(args.MakeConst(m.NewIdentityMatrix(2)), args.MakeConst(m.NewIdentityMatrix(3))); err == nil {
+		t.Error("Expected error for mismatched rows")
+	}
+	if _, err := Aug(args.MakeConst(m.NewIdentityMatrix(2)), args.MakeConst(v.MakeVector(v.RowSpace, 5, 6))); err == nil {
+		t.Error("Expected error for Row Vector")
+	}
+	if _, err := Aug(args.MakeConst(2), args.MakeConst(m.NewIdentityMatrix(2))); err == nil {
+		t.Error("Expected error for Value")
+	}
+}
+
+func TestKronHadamardCross(t *testing.T) {
+	kron := MustKron(args.MakeConst(v.MakeVector(v.RowSpace, 1, 2)), args.MakeConst(v.MakeVector(v.RowSpace, 3, 4)))
+	if kron.Vector().Len() != 4 || kron.Vector().Get(3).Complex() != 8 {
+		t.Errorf("Unexpected Kronecker product %v", kron.Vector())
+	}
+	kron = MustKron(args.MakeConst(m.NewIdentityMatrix(2)), args.MakeConst(m.NewIdentityMatrix(2)))
+	if rows, cols := kron.Matrix().Dim(); rows != 4 || cols != 4 || kron.Matrix().Get(3, 3).Complex() != 1 {
+		t.Errorf("Unexpected Kronecker product %v", kron.Matrix())
+	}
+
+	hadamard := MustHadamard(args.MakeConst(m.MakeMatrix(v.MakeVector(v.RowSpace, 2, 3))), args.MakeConst(m.MakeMatrix(v.MakeVector(v.RowSpace, 4, 5))))
+	if hadamard.Matrix().Get(0, 0).Complex() != 8 || hadamard.Matrix().Get(0, 1).Complex() != 15 {
+		t.Errorf("Unexpected Hadamard product %v", hadamard.Matrix())
+	}
+
+	cross := MustCross(args.MakeConst(v.MakeVector(v.ColSpace, 1, 0, 0)), args.MakeConst(v.MakeVector(v.ColSpace, 0, 1, 0)))
+	if cross.Vector().Get(2).Complex() != 1 {
+		t.Errorf("Unexpected cross product %v", cross.Vector())
+	}
+
+	if _, err := Kron(args.MakeConst(v.MakeVector(v.RowSpace, 1, 2)), args.MakeConst(m.NewIdentityMatrix(2))); err == nil {
+		t.Error("Expected error for Vector and Matrix")
+	}
+	if _, err := Hadamard(args.MakeConst(m.NewIdentityMatrix(2)), args.MakeConst(m.NewIdentityMatrix(3))); err == nil {
+		t.Error("Expected error for mismatched sizes")
+	}
+	if _, err := Cross(args.MakeConst(v.MakeVector(v.ColSpace, 1, 0)), args.MakeConst(v.MakeVector(v.ColSpace, 0, 1))); err == nil {
+		t.Error("Expected error for 2 dimensional Vectors")
+	}
+}
+
+func TestNeg(t *testing.T) {
+	if solution := MustNeg(args.MakeConst(2 + 1i)); solution.Value().Complex() != -2-1i {
+		t.Errorf("Expected (-2-1i), received %v", solution.Value())
+	}
+	if solution := MustNeg(args.MakeConst(v.MakeVector(v.RowSpace, 1, -2))); solution.Vector().Get(0).Real() != -1 ||
+		solution.Vector().Get(1).Real() != 2 {
+		t.Errorf("Unexpected negative vector %v", solution.Vector())
+	}
+	if solution := MustNeg(args.MakeConst(m.NewIdentityMatrix(2))); solution.Matrix().Get(1, 1).Real() != -1 {
+		t.Errorf("Unexpected negative matrix %v", solution.Matrix())
+	}
+}
+
+func TestQuantity(t *testing.T) {
+	metre := args.MakeConst(gcv.MustMakeQuantity(2, "m"))
+	second := args.MakeConst(gcv.MustMakeQuantity(1, "s"))
+	radians := args.MakeConst(gcv.MustMakeQuantity(0, "rad"))
+
+	if solution, err := Add(metre, args.MakeConst(gcv.MustMakeQuantity(50, "cm"))); err != nil || solution.Value().Real() != 2.5 {
+		t.Errorf("Expected 2.5 m, received %v, %v", solution, err)
+	}
+	if solution, err := Sin(radians); err != nil || solution.Value().Real() != 0 {
+		t.Errorf("Expected 0, received %v, %v", solution, err)
+	}
+
+	errorCases := []func() (args.Const, error){
+		func() (args.Const, error) { return Add(metre, second) },
+		func() (args.Const, error) { return Sub(metre, second) },
+		func() (args.Const, error) { return Pow(args.MakeConst(2), metre) },
+		func() (args.Const, error) { return Sqrt(args.MakeConst(gcv.MustMakeQuantity(1, "m^3"))) },
+		func() (args.Const, error) { return Sin(metre) },
+		func() (args.Const, error) { return LogBase(args.MakeConst(8), metre) },
+		func() (args.Const, error) { return LogBase(metre, args.MakeConst(2)) },
+	}
+	for i, errorCase := range errorCases {
+		if solution, err := errorCase(); err == nil {
+			t.Errorf("Expected error for case %d, received %v", i, solution)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package functions
+
+import (
+	"errors"
+	"fmt"
+
+	args "github.com/NumberXNumbers/types/gc/functions/arguments"
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+var comma = ","
+
+// call is an open parenthesis while parsing. function is the function it holds the operands of,
+// or empty if it only groups, and operands is the number of operands seen so far
+type call struct {
+	function string
+	operands int
+}
+
+// parse converts the infix inputs into postfix with the shunting-yard algorithm, using the
+// operations of r. Functions bind tighter than any infix operator, so "Sin", x, "^", 2 is Sin(x)^2.
+// A "-" that does not follow an operand is negation, which binds looser than ^ and as tight as *,
+// so "-", x, "^", 2 is -(x^2) and 2, "*", "-", y is 2*(-y).
+// The operands of a function of more than one operand are put in parentheses and separated by
+// commas, as "Max", "(", a, ",", b, ")", and their number must be the arity of the function.
+// Every variable in inputs must be a key of varNum. A nil Registry has the built in operations
+func (r *Registry) parse(varNum map[args.Var]int, inputs []interface{}) ([]interface{}, map[int]args.Type, error) {
+	if r == nil {
+		r = builtins
+	}
+	var postfix []interface{}
+	var opsStack []string
+	var calls []call
+	inputTypes := make(map[int]args.Type)
+
+	push := func(input interface{}, inputType args.Type) {
+		inputTypes[len(postfix)] = inputType
+		postfix = append(postfix, input)
+	}
+	// popUntilParen moves operations to postfix until the top of opsStack is a left parenthesis
+	popUntilParen := func() bool {
+		for len(opsStack) > 0 {
+			top := opsStack[len(opsStack)-1]
+			if top == leftParen {
+				return true
+			}
+			push(top, args.Operation)
+			opsStack = opsStack[:len(opsStack)-1]
+		}
+		return false
+	}
+
+	// pushInfix moves the operations that o is applied after to postfix and then pushes o
+	pushInfix := func(name string, o *operation) {
+		for len(opsStack) > 0 {
+			top, _ := r.lookup(opsStack[len(opsStack)-1])
+			if top == nil || ((top.infix || top.prefix) && (top.precedence < o.precedence ||
+				(top.precedence == o.precedence && o.associativity == RightAssociative))) {
+				break
+			}
+			push(opsStack[len(opsStack)-1], args.Operation)
+			opsStack = opsStack[:len(opsStack)-1]
+		}
+		opsStack = append(opsStack, name)
+	}
+
+	// previous is the function in front of the input being parsed, if there is one
+	var previous string
+	// operand is true if the inputs so far end with an operand, so a "-" is subtraction
+	var operand bool
+	for i, n := range inputs {
+		function := previous
+		previous = ""
+		if err := r.checkCall(function, n); err != nil {
+			return nil, nil, err
+		}
+		if operand && r.implicitMult && r.startsOperand(n) {
+			o, _ := r.lookup("*")
+			pushInfix("*", o)
+			operand = false
+		}
+
+		switch n := n.(type) {
+		case string:
+			switch n {
+			case leftParen:
+				opsStack = append(opsStack, leftParen)
+				calls = append(calls, call{function: function, operands: 1})
+				operand = false
+			case rightParen:
+				if !popUntilParen() {
+					return nil, nil, errors.New("Mismatch of Parentheses found")
+				}
+				opsStack = opsStack[:len(opsStack)-1]
+				c := calls[len(calls)-1]
+				calls = calls[:len(calls)-1]
+				if c.function != "" {
+					o, _ := r.lookup(c.function)
+					if c.operands != o.arity {
+						return nil, nil, fmt.Errorf("Function %s takes %d operands but %d were given", c.function, o.arity, c.operands)
+					}
+					push(c.function, args.Operation)
+					opsStack = opsStack[:len(opsStack)-1]
+				}
+				operand = true
+			case comma:
+				if !popUntilParen() || calls[len(calls)-1].function == "" {
+					return nil, nil, errors.New("Comma found outside of the operands of a function")
+				}
+				calls[len(calls)-1].operands++
+				operand = false
+			default:
+				if n == "-" && !operand {
+					n = neg
+				}
+				o, ok := r.lookup(n)
+				if !ok {
+					return nil, nil, fmt.Errorf("Operation %s not supported", n)
+				}
+				if o.infix {
+					pushInfix(n, o)
+				} else {
+					if !o.prefix {
+						previous = n
+					}
+					opsStack = append(opsStack, n)
+				}
+				operand = false
+			}
+		case int, int32, int64, float32, float64, complex64, complex128, gcv.Value, v.Vector, m.Matrix:
+			push(args.MakeConst(n), args.Constant)
+			operand = true
+		case args.Const:
+			push(n, args.Constant)
+			operand = true
+		case args.Var:
+			if _, ok := varNum[n]; !ok {
+				return nil, nil, fmt.Errorf("Variable at index %d, was not registered", i)
+			}
+			push(n, args.Variable)
+			operand = true
+		default:
+			return nil, nil, errors.New("Input type not supported")
+		}
+	}
+	if err := r.checkCall(previous, nil); err != nil {
+		return nil, nil, err
+	}
+
+	if popUntilParen() {
+		return nil, nil, errors.New("Mismatch of Parentheses found")
+	}
+	return postfix, inputTypes, nil
+}
+
+// checkCall returns error if function takes more than one operand but next is not a left parenthesis
+func (r *Registry) checkCall(function string, next interface{}) error {
+	if function == "" || next == leftParen {
+		return nil
+	}
+	if o, _ := r.lookup(function); o.arity != 1 {
+		return fmt.Errorf("Function %s must be followed by (", function)
+	}
+	return nil
+}
+
+// startsOperand returns true if the input n begins an operand, so with implicit multiplication
+// it is multiplied by an operand in front of it
+func (r *Registry) startsOperand(n interface{}) bool {
+	name, ok := n.(string)
+	if !ok {
+		return true
+	}
+	if name == leftParen {
+		return true
+	}
+	o, ok := r.lookup(name)
+	return ok && !o.infix
+}
@@ -0,0 +1,159 @@
+package functions
+
+import (
+	"math"
+	"testing"
+
+	args "github.com/NumberXNumbers/types/gc/functions/arguments"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+func TestCalculateUnaryMinus(t *testing.T) {
+	testCases := []struct {
+		inputs   []interface{}
+		expected float64
+	}{
+		{[]interface{}{"-", 2, "^", 2}, -4},
+		{[]interface{}{"(", "-", 2, ")", "^", 2}, 4},
+		{[]interface{}{2, "*", "(", "-", 3, ")"}, -6},
+		{[]interface{}{2, "*", "-", 3}, -6},
+		{[]interface{}{"-", 2, "*", 3}, -6},
+		{[]interface{}{"-", 6, "/", 2, "/", 3}, -1},
+		{[]interface{}{2, "^", "-", 1}, 0.5},
+		{[]interface{}{2, "^", "-", 1, "^", 2}, 0.5},
+		{[]interface{}{1, "-", "-", 1}, 2},
+		{[]interface{}{"-", "-", 3}, 3},
+		{[]interface{}{2, "-", "-", 3, "^", 2}, 11},
+		{[]interface{}{"-", 1, "+", 3}, 2},
+		{[]interface{}{"Max", "(", "-", 1, ",", "-", 2, ")"}, -1},
+		{[]interface{}{"Sin", "-", math.Pi / 2}, -1},
+		{[]interface{}{"-", "Sin", math.Pi / 2, "^", 2}, -1},
+		{[]interface{}{"-", "(", 1, "+", 2, ")"}, -3},
+	}
+
+	for _, testCase := range testCases {
+		calculation, err := Calculate(testCase.inputs...)
+		if err != nil || math.Abs(calculation.Value().Real()-testCase.expected) > 1e-15 {
+			t.Errorf("Expected %v for %v, received %v, %v", testCase.expected, testCase.inputs, calculation, err)
+		}
+	}
+}
+
+func TestFuncUnaryMinus(t *testing.T) {
+	x := args.NewVar(args.Value)
+	y := args.NewVar(args.Value)
+	regVars := []args.Var{x, y}
+
+	testCases := []struct {
+		inputs   []interface{}
+		expected float64
+		infix    string
+		grad     []float64
+	}{
+		{[]interface{}{"-", x, "^", 2}, -9, "-x^2", []float64{-6, 0}},
+		{[]interface{}{"(", "-", x, ")", "^", 2}, 9, "(-x)^2", []float64{6, 0}},
+		{[]interface{}{2, "*", "(", "-", y, ")"}, -4, "2*(-y)", []float64{0, -2}},
+		{[]interface{}{x, "*", "-", y}, -6, "x*(-y)", []float64{-2, -3}},
+		{[]interface{}{"-", x, "*", y}, -6, "-x*y", []float64{-2, -3}},
+		{[]interface{}{x, "-", "-", y}, 5, "x - (-y)", []float64{1, 1}},
+		{[]interface{}{"-", "-", x}, 3, "-(-x)", []float64{1, 0}},
+		{[]interface{}{"-", "(", x, "+", y, ")"}, -5, "-(x + y)", []float64{-1, -1}},
+		{[]interface{}{"-", x, "+", y}, -1, "-x + y", []float64{-1, 1}},
+		{[]interface{}{"-", 2, "*", x}, -6, "-2*x", []float64{-2, 0}},
+	}
+
+	for _, testCase := range testCases {
+		function := MakeFuncPanic(regVars, testCase.inputs...)
+		if value := function.MustEval(3, 2); math.Abs(value.Value().Real()-testCase.expected) > 1e-15 {
+			t.Errorf("Expected %v for %v, received %v", testCase.expected, testCase.inputs, value)
+		}
+		if value := function.MustCompile(); math.Abs(evalCompiled(value, 3, 2)-testCase.expected) > 1e-15 {
+			t.Errorf("Expected compiled %v for %v", testCase.expected, testCase.inputs)
+		}
+		if s, err := function.Infix("x", "y"); err != nil || s != testCase.infix {
+			t.Errorf("Expected %q, received %q, %v", testCase.infix, s, err)
+		}
+		grads := function.MustGrad(3, 2)
+		for i, grad := range grads {
+			if grad.Value().Real() != testCase.grad[i] {
+				t.Errorf("Expected gradient %v for %v, received %v", testCase.grad, testCase.inputs, grads)
+			}
+		}
+	}
+
+	if s := MakeFuncPanic(regVars, "-", "-", x).MustSimplify().String(); s != "x1" {
+		t.Errorf("Expected x1, received %q", s)
+	}
+	if s, _ := MakeFuncPanic(regVars, "-", x, "/", 2).LaTeX("x", "y"); s != `\frac{-x}{2}` {
+		t.Errorf("Expected \\frac{-x}{2}, received %q", s)
+	}
+
+	vector := args.NewVar(args.Vector)
+	negative := MakeFuncPanic([]args.Var{vector}, "-", vector).MustEval(v.MakeVector(v.RowSpace, 1, -2))
+	if negative.Vector().Get(0).Real() != -1 || negative.Vector().Get(1).Real() != 2 {
+		t.Errorf("Unexpected negative vector %v", negative.Vector())
+	}
+}
+
+// evalCompiled returns the Real result of a compiled function, or panics
+func evalCompiled(compiled func(...interface{}) (args.Const, error), inputs ...interface{}) float64 {
+	value, err := compiled(inputs...)
+	if err != nil {
+		panic(err)
+	}
+	return value.Value().Real()
+}
+
+func TestImplicitMult(t *testing.T) {
+	registry := NewRegistry()
+	registry.SetImplicitMult(true)
+
+	testCases := []struct {
+		inputs   []interface{}
+		expected float64
+	}{
+		{[]interface{}{2, "(", 3, ")"}, 6},
+		{[]interface{}{"(", 2, ")", "(", 3, ")"}, 6},
+		{[]interface{}{"(", 2, ")", 3}, 6},
+		{[]interface{}{3, "Sin", "(", math.Pi / 2, ")"}, 3},
+		{[]interface{}{2, "Max", "(", 1, ",", 3, ")"}, 6},
+		{[]interface{}{2, "(", 1, "+", 2, ")", "^", 2}, 18},
+		{[]interface{}{1, "/", 2, 4}, 2},
+		{[]interface{}{2, "-", 3}, -1},
+		{[]interface{}{2, "(", "-", 3, ")"}, -6},
+		{[]interface{}{"(", 1, "+", 1, ")", "-", 3}, -1},
+	}
+
+	for _, testCase := range testCases {
+		calculation, err := CalculateWith(registry, testCase.inputs...)
+		if err != nil || math.Abs(calculation.Value().Real()-testCase.expected) > 1e-15 {
+			t.Errorf("Expected %v for %v, received %v, %v", testCase.expected, testCase.inputs, calculation, err)
+		}
+	}
+
+	x := args.NewVar(args.Value)
+	y := args.NewVar(args.Value)
+	function := MakeFuncWithPanic(registry.NewChild(), []args.Var{x, y}, 2, x, "^", 2, "+", 3, x, y, "-", "(", x, ")", "(", y, ")")
+	if value := function.MustEval(2, 5); value.Value().Real() != 28 {
+		t.Errorf("Expected 28, received %v", value)
+	}
+	if s, err := function.Infix("x", "y"); err != nil || s != "2*x^2 + 3*x*y - x*y" {
+		t.Errorf("Expected 2*x^2 + 3*x*y - x*y, received %q, %v", s, err)
+	}
+
+	if _, err := Calculate(2, "(", 3, ")"); err == nil {
+		t.Error("Expected error without implicit multiplication")
+	}
+	if _, err := CalculateWith(NewRegistry(), "(", 2, ")", "(", 3, ")"); err == nil {
+		t.Error("Expected error without implicit multiplication")
+	}
+
+	var none *Registry
+	none.SetImplicitMult(true)
+	if _, _, err := none.parse(nil, []interface{}{2, "*", "-", 3}); err != nil {
+		t.Errorf("Expected a nil Registry to parse with the built in operations, received %v", err)
+	}
+	if _, err := Calculate(2, "(", 3, ")"); err == nil {
+		t.Error("Expected the built in operations to be left without implicit multiplication")
+	}
+}
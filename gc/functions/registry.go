@@ -0,0 +1,185 @@
+package functions
+
+import (
+	"errors"
+	"fmt"
+
+	args "github.com/NumberXNumbers/types/gc/functions/arguments"
+	"github.com/NumberXNumbers/types/gc/functions/ops"
+)
+
+// Associativity is the way a chain of infix operators of the same precedence is grouped
+type Associativity int
+
+const (
+	// LeftAssociative operators group from the left, a-b-c is (a-b)-c
+	LeftAssociative Associativity = iota
+	// RightAssociative operators group from the right, a^b^c is a^(b^c)
+	RightAssociative
+)
+
+// operation is an operation an expression can use. An infix operation is written between its
+// two operands, a prefix operation, such as -x, is written in front of its one operand and
+// any other operation is a function written in front of its operands.
+// unary and binary are set for operations of one or two operands, so they can be called
+// without building a slice of the operands
+type operation struct {
+	arity         int
+	infix         bool
+	prefix        bool
+	precedence    uint
+	associativity Associativity
+	eval          func(operands ...args.Const) (args.Const, error)
+	unary         func(args.Const) (args.Const, error)
+	binary        func(args.Const, args.Const) (args.Const, error)
+}
+
+// apply pops the operands of o off of stack and pushes its result
+func (o *operation) apply(stack []args.Const) ([]args.Const, error) {
+	if len(stack) < o.arity {
+		return nil, errors.New("Not enough operands")
+	}
+	split := len(stack) - o.arity
+	result, err := o.eval(stack[split:]...)
+	if err != nil {
+		return nil, err
+	}
+	return append(stack[:split], result), nil
+}
+
+// Registry holds the operations that expressions can use. A Registry sees every operation
+// of the Registry it was made from, but operations registered with it are only seen by it and
+// the Registries made from it, so each Function or environment can have its own operations.
+// A Registry is safe to use from many goroutines once nothing more is being registered
+type Registry struct {
+	parent       *Registry
+	operations   map[string]*operation
+	implicitMult bool
+}
+
+// builtins is the Registry of the built in operations, used by MakeFunc and Calculate
+var builtins = newBuiltins()
+
+func newBuiltins() *Registry {
+	registry := &Registry{operations: make(map[string]*operation)}
+	for name, h := range unaryFuncs {
+		registry.operations[name] = unaryOperation(h)
+	}
+	for symbol, h := range binaryFuncs {
+		associativity := LeftAssociative
+		if symbol == pow {
+			associativity = RightAssociative
+		}
+		registry.operations[symbol] = infixOperation(orderOfOperations[symbol], associativity, h)
+	}
+	for name, h := range binaryPrefixFuncs {
+		registry.operations[name] = binaryOperation(h)
+	}
+	negate := unaryOperation(ops.Neg)
+	negate.prefix = true
+	negate.precedence = orderOfOperations[neg]
+	registry.operations[neg] = negate
+	return registry
+}
+
+func unaryOperation(h func(args.Const) (args.Const, error)) *operation {
+	return &operation{
+		arity: 1,
+		unary: h,
+		eval:  func(operands ...args.Const) (args.Const, error) { return h(operands[0]) },
+	}
+}
+
+func binaryOperation(h func(args.Const, args.Const) (args.Const, error)) *operation {
+	return &operation{
+		arity:  2,
+		binary: h,
+		eval:   func(operands ...args.Const) (args.Const, error) { return h(operands[0], operands[1]) },
+	}
+}
+
+func infixOperation(precedence uint, associativity Associativity, h func(args.Const, args.Const) (args.Const, error)) *operation {
+	o := binaryOperation(h)
+	o.infix = true
+	o.precedence = precedence
+	o.associativity = associativity
+	return o
+}
+
+// NewRegistry returns a new Registry with the built in operations
+func NewRegistry() *Registry { return builtins.NewChild() }
+
+// NewChild returns a new Registry with every operation of r and the implicit multiplication of r.
+// Operations registered with the child are not seen by r. A nil Registry has the built in operations
+func (r *Registry) NewChild() *Registry {
+	if r == nil {
+		r = builtins
+	}
+	return &Registry{parent: r, operations: make(map[string]*operation), implicitMult: r.implicitMult}
+}
+
+// SetImplicitMult sets whether an operand directly after another operand is multiplied by it,
+// so 2, x is 2*x, 3, "Sin", "(", x, ")" is 3*Sin(x) and "(", a, ")", "(", b, ")" is (a)*(b).
+// It has the precedence of *, so 1, "/", 2, x is (1/2)*x. It is off unless set.
+// A nil Registry is the built in operations, which are left unchanged
+func (r *Registry) SetImplicitMult(on bool) {
+	if r != nil {
+		r.implicitMult = on
+	}
+}
+
+// lookup returns the operation name of r or of the Registry it was made from.
+// A nil Registry has the built in operations
+func (r *Registry) lookup(name string) (*operation, bool) {
+	if r == nil {
+		r = builtins
+	}
+	for ; r != nil; r = r.parent {
+		if o, ok := r.operations[name]; ok {
+			return o, true
+		}
+	}
+	return nil, false
+}
+
+func (r *Registry) register(name string, o *operation) error {
+	if r == nil {
+		return errors.New("Can not register with a nil Registry, use NewRegistry")
+	}
+	if name == "" || name == leftParen || name == rightParen || name == comma {
+		return fmt.Errorf("%q can not be registered as an operation", name)
+	}
+	if _, ok := r.lookup(name); ok {
+		return fmt.Errorf("Operation %s is already registered", name)
+	}
+	if r.operations == nil {
+		r.operations = make(map[string]*operation)
+	}
+	r.operations[name] = o
+	return nil
+}
+
+// RegisterUnary registers the function name of one operand, written in front of its operand
+// like the built in Sin, as "Sin", x or "Sin", "(", x, ")".
+// Returns error if name is already registered
+func (r *Registry) RegisterUnary(name string, h func(args.Const) (args.Const, error)) error {
+	return r.register(name, unaryOperation(h))
+}
+
+// RegisterBinary registers the infix operator symbol, written between its two operands.
+// Operators of a higher precedence are applied first, the built in + and - have a precedence
+// of 1, * and / of 2 and ^ of 3. associativity groups a chain of operators of the same precedence.
+// Returns error if symbol is already registered
+func (r *Registry) RegisterBinary(symbol string, precedence uint, associativity Associativity, h func(args.Const, args.Const) (args.Const, error)) error {
+	return r.register(symbol, infixOperation(precedence, associativity, h))
+}
+
+// RegisterFunc registers the function name of arity operands, written in front of its
+// operands as "Name", "(", a, ",", b, ")". h is given the operands in order and must not keep the slice.
+// Returns error if name is already registered or arity is less than 1
+func (r *Registry) RegisterFunc(name string, arity int, h func(operands ...args.Const) (args.Const, error)) error {
+	if arity < 1 {
+		return errors.New("Arity must be at least 1")
+	}
+	return r.register(name, &operation{arity: arity, eval: h})
+}
@@ -0,0 +1,214 @@
+package functions
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	args "github.com/NumberXNumbers/types/gc/functions/arguments"
+	"github.com/NumberXNumbers/types/gc/functions/ops"
+)
+
+func mod(a args.Const, b args.Const) (args.Const, error) {
+	if a.Type() != args.Value || b.Type() != args.Value {
+		return nil, errors.New("Mod is only supported for Values")
+	}
+	return args.MakeConst(math.Mod(a.Value().Real(), b.Value().Real())), nil
+}
+
+func double(a args.Const) (args.Const, error) { return ops.Mult(args.MakeConst(2), a) }
+
+func clamp(operands ...args.Const) (args.Const, error) {
+	x, low, high := operands[0].Value().Real(), operands[1].Value().Real(), operands[2].Value().Real()
+	return args.MakeConst(math.Max(low, math.Min(high, x))), nil
+}
+
+func testRegistry(t *testing.T) *Registry {
+	registry := NewRegistry()
+	if err := registry.RegisterBinary("%", 2, LeftAssociative, mod); err != nil {
+		t.Fatal(err)
+	}
+	if err := registry.RegisterBinary("**", 3, RightAssociative, ops.Pow); err != nil {
+		t.Fatal(err)
+	}
+	if err := registry.RegisterUnary("Double", double); err != nil {
+		t.Fatal(err)
+	}
+	if err := registry.RegisterFunc("Clamp", 3, clamp); err != nil {
+		t.Fatal(err)
+	}
+	return registry
+}
+
+func TestCalculateWith(t *testing.T) {
+	registry := testRegistry(t)
+
+	testCases := []struct {
+		inputs   []interface{}
+		expected float64
+	}{
+		{[]interface{}{7, "%", 4, "+", 1}, 4},
+		{[]interface{}{1, "+", 7, "%", 4}, 4},
+		{[]interface{}{2, "*", 7, "%", 4}, 2},
+		{[]interface{}{2, "**", 3, "**", 2}, 512},
+		{[]interface{}{"Double", 3, "^", 2}, 36},
+		{[]interface{}{"Double", "(", 1, "+", 2, ")"}, 6},
+		{[]interface{}{"Clamp", "(", 5, ",", 0, ",", 1, ")"}, 1},
+		{[]interface{}{"Clamp", "(", "(", 1, "-", 3, ")", "*", 2, ",", -1, ",", "Double", 2, ")", "+", 1}, 0},
+		{[]interface{}{"Sin", "(", "Clamp", "(", 0, ",", -1, ",", 1, ")", ")"}, 0},
+	}
+
+	for _, testCase := range testCases {
+		calculation, err := CalculateWith(registry, testCase.inputs...)
+		if err != nil || calculation.Value().Real() != testCase.expected {
+			t.Errorf("Expected %v for %v, received %v, %v", testCase.expected, testCase.inputs, calculation, err)
+		}
+	}
+}
+
+func TestCalculateWithErrors(t *testing.T) {
+	registry := testRegistry(t)
+
+	testCases := [][]interface{}{
+		{"Clamp", "(", 5, ",", 0, ")"},
+		{"Clamp", "(", 5, ",", 0, ",", 1, ",", 2, ")"},
+		{"Clamp", 5},
+		{"Clamp"},
+		{"(", 1, ",", 2, ")"},
+		{1, ",", 2},
+		{"Clamp", "(", 5, ",", 0, ",", 1},
+	}
+
+	for _, inputs := range testCases {
+		if _, err := CalculateWith(registry, inputs...); err == nil {
+			t.Errorf("Expected error for %v", inputs)
+		}
+	}
+}
+
+func TestRegistryScope(t *testing.T) {
+	registry := testRegistry(t)
+	child := registry.NewChild()
+	if err := child.RegisterUnary("Triple", func(a args.Const) (args.Const, error) { return ops.Mult(args.MakeConst(3), a) }); err != nil {
+		t.Fatal(err)
+	}
+
+	if calculation := MustCalculateWith(child, "Triple", "Double", 1); calculation.Value().Real() != 6 {
+		t.Errorf("Expected 6, received %v", calculation)
+	}
+	if _, err := CalculateWith(registry, "Triple", 1); err == nil {
+		t.Error("Expected operation of child to not be in parent")
+	}
+	if _, err := CalculateWith(NewRegistry(), "Double", 1); err == nil {
+		t.Error("Expected operation to not be in a new registry")
+	}
+	if _, err := Calculate(7, "%", 4); err == nil {
+		t.Error("Expected operation to not be built in")
+	}
+}
+
+func TestRegistryRegisterErrors(t *testing.T) {
+	registry := testRegistry(t)
+	child := registry.NewChild()
+
+	if err := child.RegisterUnary("Sin", double); err == nil {
+		t.Error("Expected error registering a built in operation")
+	}
+	if err := child.RegisterBinary("%", 1, LeftAssociative, mod); err == nil {
+		t.Error("Expected error registering an operation of the parent")
+	}
+	for _, name := range []string{"", "(", ")", ","} {
+		if err := registry.RegisterUnary(name, double); err == nil {
+			t.Errorf("Expected error registering %q", name)
+		}
+	}
+	if err := registry.RegisterFunc("None", 0, clamp); err == nil {
+		t.Error("Expected error registering a function of no operands")
+	}
+}
+
+func TestMakeFuncWith(t *testing.T) {
+	registry := testRegistry(t)
+	x := args.NewVar(args.Value)
+	y := args.NewVar(args.Value)
+	regVars := []args.Var{x, y}
+
+	function := MakeFuncWithPanic(registry, regVars, "Clamp", "(", x, "%", 3, ",", 0, ",", y, ")", "+", "Double", x)
+	if value := function.MustEval(5, 1); value.Value().Real() != 11 {
+		t.Errorf("Expected 11, received %v", value)
+	}
+	compiled := function.MustCompile()
+	if value, err := compiled(5, 3); err != nil || value.Value().Real() != 12 {
+		t.Errorf("Expected 12, received %v, %v", value, err)
+	}
+	if s, err := function.Infix("x", "y"); err != nil || s != "Clamp(x % 3, 0, y) + Double(x)" {
+		t.Errorf("Expected Clamp(x %% 3, 0, y) + Double(x), received %q, %v", s, err)
+	}
+	if s, err := function.LaTeX("x", "y"); err != nil || s != `\operatorname{Clamp}\left(x % 3, 0, y\right) + \operatorname{Double}\left(x\right)` {
+		t.Errorf("Unexpected LaTeX %q, %v", s, err)
+	}
+
+	power := MakeFuncWithPanic(registry, regVars, "(", x, "**", y, ")", "**", 2, "-", x, "**", y, "**", 2)
+	if s, err := power.Infix("x", "y"); err != nil || s != "(x ** y) ** 2 - x ** y ** 2" {
+		t.Errorf("Expected (x ** y) ** 2 - x ** y ** 2, received %q, %v", s, err)
+	}
+
+	if _, err := MakeFunc(regVars, "Double", x); err == nil {
+		t.Error("Expected error using an operation that is not built in")
+	}
+}
+
+func TestFunctionWithRegistry(t *testing.T) {
+	registry := testRegistry(t)
+	x := args.NewVar(args.Value)
+
+	function := MakeFuncWithPanic(registry, []args.Var{x}, x, "+", "Double", "(", 3, "%", 2, ")")
+	simplified := function.MustSimplify()
+	if s := simplified.String(); s != "x + 2" {
+		t.Errorf("Expected x + 2, received %q", s)
+	}
+
+	g := MakeFuncPanic([]args.Var{x}, x, "^", 2)
+	if composed, err := Compose(function, g); err != nil || composed.MustEval(2).Value().Real() != 6 {
+		t.Errorf("Expected 6, received %v", err)
+	}
+	if _, err := Compose(g, function); err == nil {
+		t.Error("Expected error composing with an operation that is not in the registry")
+	}
+
+	if _, err := MakeFuncWithPanic(registry, []args.Var{x}, "Double", x).Grad(1); err == nil {
+		t.Error("Expected error finding the gradient through a registered operation")
+	}
+}
+
+func TestNilRegistry(t *testing.T) {
+	var registry *Registry
+
+	if calculation, err := CalculateWith(registry, 1, "+", 2); err != nil || calculation.Value().Real() != 3 {
+		t.Errorf("Expected 3, received %v, %v", calculation, err)
+	}
+
+	x := args.NewVar(args.Value)
+	function, err := MakeFuncWith(registry, []args.Var{x}, "-", x, "^", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value := function.MustEval(3); value.Value().Real() != -9 {
+		t.Errorf("Expected -9, received %v", value)
+	}
+	if s, err := function.Infix("x"); err != nil || s != "-x^2" {
+		t.Errorf("Expected -x^2, received %q, %v", s, err)
+	}
+
+	if err := registry.RegisterUnary("Double", double); err == nil {
+		t.Error("Expected error registering with a nil Registry")
+	}
+
+	child := registry.NewChild()
+	if err := child.RegisterUnary("Double", double); err != nil {
+		t.Fatal(err)
+	}
+	if calculation := MustCalculateWith(child, "Double", 2); calculation.Value().Real() != 4 {
+		t.Errorf("Expected 4, received %v", calculation)
+	}
+}
@@ -0,0 +1,360 @@
+package functions
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	args "github.com/NumberXNumbers/types/gc/functions/arguments"
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+// notation describes how each part of an expression is written
+type notation struct {
+	name     func(name string) string
+	number   func(number string) string
+	paren    func(s string) string
+	function func(name string, operands []string) string
+	negate   func(operand string) string
+	binary   func(operation, left, right string) string
+	elements func(rows [][]string) string
+	// fractions is true if division is drawn as a fraction, so its operands never need parentheses
+	fractions bool
+	// raised is true if a power is drawn raised, so the exponent never needs parentheses
+	raised bool
+}
+
+var infix = &notation{
+	name:   func(name string) string { return name },
+	number: func(number string) string { return number },
+	paren:  func(s string) string { return "(" + s + ")" },
+	function: func(name string, operands []string) string {
+		return name + "(" + strings.Join(operands, ", ") + ")"
+	},
+	negate: func(operand string) string { return "-" + operand },
+	binary: func(operation, left, right string) string {
+		if operation == "*" || operation == "/" || operation == pow {
+			return left + operation + right
+		}
+		return left + " " + operation + " " + right
+	},
+	elements: func(rows [][]string) string {
+		joined := make([]string, len(rows))
+		for i, row := range rows {
+			joined[i] = strings.Join(row, ", ")
+		}
+		return "[" + strings.Join(joined, "; ") + "]"
+	},
+}
+
+var latexFuncs = map[string]string{
+	"Sin":   `\sin`,
+	"Cos":   `\cos`,
+	"Tan":   `\tan`,
+	"Asin":  `\arcsin`,
+	"Acos":  `\arccos`,
+	"Atan":  `\arctan`,
+	"Sinh":  `\sinh`,
+	"Cosh":  `\cosh`,
+	"Tanh":  `\tanh`,
+	"Asinh": `\operatorname{arsinh}`,
+	"Acosh": `\operatorname{arcosh}`,
+	"Atanh": `\operatorname{artanh}`,
+	"Max":   `\max`,
+	"Min":   `\min`,
+}
+
+var latex = &notation{
+	name:   func(name string) string { return name },
+	number: func(number string) string { return number },
+	paren:  func(s string) string { return `\left(` + s + `\right)` },
+	function: func(name string, operands []string) string {
+		switch name {
+		case "Sqrt":
+			return `\sqrt{` + operands[0] + `}`
+		case "Conj":
+			return `\overline{` + operands[0] + `}`
+		}
+		operator, ok := latexFuncs[name]
+		if !ok {
+			operator = `\operatorname{` + name + `}`
+		}
+		return operator + `\left(` + strings.Join(operands, ", ") + `\right)`
+	},
+	negate: func(operand string) string { return "-" + operand },
+	binary: func(operation, left, right string) string {
+		switch operation {
+		case "*":
+			return left + ` \cdot ` + right
+		case "/":
+			return `\frac{` + left + `}{` + right + `}`
+		case pow:
+			return left + `^{` + right + `}`
+		}
+		return left + " " + operation + " " + right
+	},
+	elements: func(rows [][]string) string {
+		joined := make([]string, len(rows))
+		for i, row := range rows {
+			joined[i] = strings.Join(row, " & ")
+		}
+		return `\begin{bmatrix}` + strings.Join(joined, ` \\ `) + `\end{bmatrix}`
+	},
+	fractions: true,
+	raised:    true,
+}
+
+var escapeXML = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace
+
+// mrow wraps s in an mrow element so it is a single argument of msup or mfrac
+func mrow(s string) string { return "<mrow>" + s + "</mrow>" }
+
+var mathML = &notation{
+	name: func(name string) string { return "<mi>" + escapeXML(name) + "</mi>" },
+	number: func(number string) string {
+		if strings.HasPrefix(number, "-") {
+			return "<mo>-</mo><mn>" + number[1:] + "</mn>"
+		}
+		return "<mn>" + number + "</mn>"
+	},
+	paren: func(s string) string { return mrow("<mo>(</mo>" + s + "<mo>)</mo>") },
+	function: func(name string, operands []string) string {
+		switch name {
+		case "Sqrt":
+			return "<msqrt>" + operands[0] + "</msqrt>"
+		case "Conj":
+			return "<mover>" + mrow(operands[0]) + "<mo>&#xAF;</mo></mover>"
+		}
+		if _, ok := unaryFuncs[name]; ok {
+			name = strings.ToLower(name)
+		}
+		return mrow("<mi>" + escapeXML(name) + "</mi><mo>&#x2061;</mo>" +
+			mrow("<mo>(</mo>"+strings.Join(operands, "<mo>,</mo>")+"<mo>)</mo>"))
+	},
+	negate: func(operand string) string { return mrow("<mo>-</mo>" + operand) },
+	binary: func(operation, left, right string) string {
+		switch operation {
+		case "*":
+			return mrow(left + "<mo>&#x22C5;</mo>" + right)
+		case "/":
+			return "<mfrac>" + mrow(left) + mrow(right) + "</mfrac>"
+		case pow:
+			return "<msup>" + mrow(left) + mrow(right) + "</msup>"
+		}
+		return mrow(left + "<mo>" + escapeXML(operation) + "</mo>" + right)
+	},
+	elements: func(rows [][]string) string {
+		table := ""
+		for _, row := range rows {
+			table += "<mtr>"
+			for _, element := range row {
+				table += "<mtd>" + element + "</mtd>"
+			}
+			table += "</mtr>"
+		}
+		return mrow("<mo>[</mo><mtable>" + table + "</mtable><mo>]</mo>")
+	},
+	fractions: true,
+	raised:    true,
+}
+
+// formatNumber returns a Real or Complex value as a plain number, such as "2", "-0.5", "3i" or "1+2i".
+// compound is true if the number needs parentheses as an operand of most operations
+func formatNumber(value gcv.Value) (number string, compound bool) {
+	format := func(x float64) string { return strconv.FormatFloat(x, 'g', -1, 64) }
+	if value.Type() == gcv.Real || value.Imag() == 0 {
+		return format(value.Real()), value.Real() < 0 || math.Signbit(value.Real())
+	}
+	imag := format(value.Imag()) + "i"
+	if value.Real() == 0 {
+		return imag, value.Imag() < 0
+	}
+	if value.Imag() > 0 {
+		imag = "+" + imag
+	}
+	return format(value.Real()) + imag, true
+}
+
+// renderer writes the expression tree of a Function in a notation
+type renderer struct {
+	notation *notation
+	names    map[args.Var]string
+	registry *Registry
+}
+
+// value returns the string of value and whether it needs parentheses as an operand
+func (r *renderer) value(value gcv.Value) (string, bool) {
+	if value.Type() > gcv.Complex {
+		return r.notation.number(value.String()), false
+	}
+	number, compound := formatNumber(value)
+	return r.notation.number(number), compound
+}
+
+// constant returns the string of c and whether it needs parentheses as an operand
+func (r *renderer) constant(c args.Const) (string, bool) {
+	switch c.Type() {
+	case args.Vector:
+		vector := c.Vector()
+		elements := make([]string, vector.Len())
+		for i := range elements {
+			elements[i], _ = r.value(vector.Get(i))
+		}
+		if vector.Space() == v.RowSpace {
+			return r.notation.elements([][]string{elements}), false
+		}
+		rows := make([][]string, len(elements))
+		for i, element := range elements {
+			rows[i] = []string{element}
+		}
+		return r.notation.elements(rows), false
+	case args.Matrix:
+		matrix := c.Matrix()
+		rows := make([][]string, matrix.GetNumRows())
+		for i := range rows {
+			rows[i] = make([]string, matrix.GetNumCols())
+			for j := range rows[i] {
+				rows[i][j], _ = r.value(matrix.Get(i, j))
+			}
+		}
+		return r.notation.elements(rows), false
+	}
+	return r.value(c.Value())
+}
+
+// needsParens returns true if the operand at index of parent must be put in parentheses
+func (r *renderer) needsParens(parent *node, index int) bool {
+	child := parent.operands[index]
+	if (r.notation.fractions && parent.operation == "/") || (r.notation.raised && parent.operation == pow && index == 1) {
+		return false
+	}
+	if child.isConst() {
+		_, compound := r.constant(child.constant)
+		return compound && !(index == 0 && (parent.operation == "+" || parent.operation == "-"))
+	}
+	parentOperation, _ := r.registry.lookup(parent.operation)
+	childOperation, _ := r.registry.lookup(child.operation)
+	if childOperation != nil && childOperation.prefix {
+		// x*-y is written x*(-y), but -x*y needs none as the negation is applied first
+		return index > 0 || parentOperation.precedence > childOperation.precedence
+	}
+	if childOperation == nil || !childOperation.infix {
+		return false
+	}
+	if r.notation.fractions && child.operation == "/" {
+		return parent.operation == pow && index == 0
+	}
+	switch {
+	case childOperation.precedence < parentOperation.precedence:
+		return true
+	case childOperation.precedence > parentOperation.precedence:
+		return false
+	case parentOperation.associativity == RightAssociative:
+		return index == 0
+	}
+	return index == 1
+}
+
+// prefixNeedsParens returns true if the operand child of the prefix operation o must be put in parentheses
+func (r *renderer) prefixNeedsParens(o *operation, child *node) bool {
+	if child.isConst() {
+		_, compound := r.constant(child.constant)
+		return compound
+	}
+	childOperation, _ := r.registry.lookup(child.operation)
+	if childOperation == nil || (!childOperation.infix && !childOperation.prefix) {
+		return false
+	}
+	if r.notation.fractions && child.operation == "/" {
+		return false
+	}
+	return childOperation.prefix || childOperation.precedence <= o.precedence
+}
+
+func (r *renderer) render(n *node) string {
+	switch {
+	case n.isConst():
+		s, _ := r.constant(n.constant)
+		return s
+	case n.variable != nil:
+		return r.notation.name(r.names[n.variable])
+	}
+
+	o, _ := r.registry.lookup(n.operation)
+	if o.prefix {
+		operand := r.render(n.operands[0])
+		if r.prefixNeedsParens(o, n.operands[0]) {
+			operand = r.notation.paren(operand)
+		}
+		return r.notation.negate(operand)
+	}
+	operands := make([]string, len(n.operands))
+	for i, operand := range n.operands {
+		operands[i] = r.render(operand)
+		if o.infix && r.needsParens(n, i) {
+			operands[i] = r.notation.paren(operands[i])
+		}
+	}
+	if !o.infix {
+		return r.notation.function(n.operation, operands)
+	}
+	return r.notation.binary(n.operation, operands[0], operands[1])
+}
+
+// write returns f in notation with its registered variables called names. Without names the
+// variable is called x, or x1, x2 and so on if there is more than one.
+// Returns error if names are given but there is not one for each variable
+func (f *Function) write(notation *notation, names []string) (string, error) {
+	if len(names) == 0 {
+		names = make([]string, f.numVars)
+		for i := range names {
+			names[i] = "x"
+			if f.numVars > 1 {
+				names[i] += strconv.Itoa(i + 1)
+			}
+		}
+	}
+	if len(names) != f.numVars {
+		return "", fmt.Errorf("Function has %d variables but %d names were given", f.numVars, len(names))
+	}
+	root, err := f.tree()
+	if err != nil {
+		return "", err
+	}
+
+	r := &renderer{notation: notation, names: make(map[args.Var]string), registry: f.registry}
+	for i, variable := range f.regVars {
+		r.names[variable] = names[i]
+	}
+	return r.render(root), nil
+}
+
+// Infix returns f in infix notation, such as "Sin(x)^2 + 3*y", with the fewest parentheses needed.
+// names are the names of the registered variables in order. Without names the variable is called x,
+// or x1, x2 and so on if there is more than one.
+// Returns error if names are given but there is not one for each variable
+func (f *Function) Infix(names ...string) (string, error) { return f.write(infix, names) }
+
+// LaTeX returns f as LaTeX, such as `\sin\left(x\right)^{2}`, with the variables called names, see Infix
+func (f *Function) LaTeX(names ...string) (string, error) { return f.write(latex, names) }
+
+// MathML returns f as a presentation MathML math element, with the variables called names, see Infix
+func (f *Function) MathML(names ...string) (string, error) {
+	s, err := f.write(mathML, names)
+	if err != nil {
+		return "", err
+	}
+	return `<math xmlns="http://www.w3.org/1998/Math/MathML">` + s + "</math>", nil
+}
+
+// String returns f in infix notation with the default variable names of Infix,
+// or its postfix Args if they do not form an expression
+func (f *Function) String() string {
+	s, err := f.Infix()
+	if err != nil {
+		return fmt.Sprint(f.Args)
+	}
+	return s
+}
@@ -0,0 +1,112 @@
+package functions
+
+import (
+	"testing"
+
+	args "github.com/NumberXNumbers/types/gc/functions/arguments"
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+func TestInfix(t *testing.T) {
+	x := args.NewVar(args.Value)
+	y := args.NewVar(args.Value)
+	regVars := []args.Var{x, y}
+
+	testCases := []struct {
+		inputs   []interface{}
+		expected string
+	}{
+		{[]interface{}{x, "+", y, "*", 3}, "x + y*3"},
+		{[]interface{}{"(", x, "+", y, ")", "*", 3}, "(x + y)*3"},
+		{[]interface{}{x, "-", "(", y, "-", 1, ")"}, "x - (y - 1)"},
+		{[]interface{}{x, "-", y, "-", 1}, "x - y - 1"},
+		{[]interface{}{x, "/", "(", y, "*", 2, ")"}, "x/(y*2)"},
+		{[]interface{}{x, "^", y, "^", 2}, "x^y^2"},
+		{[]interface{}{"(", x, "^", y, ")", "^", 2}, "(x^y)^2"},
+		{[]interface{}{"Sin", "(", x, ")", "^", 2, "+", "Sqrt", "(", y, "+", 1, ")"}, "Sin(x)^2 + Sqrt(y + 1)"},
+		{[]interface{}{-2, "*", x, "+", x, "^", -1}, "(-2)*x + x^(-1)"},
+		{[]interface{}{-2, "+", x, "*", 1.5, "-", 3i}, "-2 + x*1.5 - 3i"},
+		{[]interface{}{y, "*", 1 + 2i}, "y*(1+2i)"},
+		{[]interface{}{x, "*", v.MakeVector(v.RowSpace, 1, 2), "+", m.NewIdentityMatrix(2)}, "x*[1, 2] + [1, 0; 0, 1]"},
+		{[]interface{}{x, "*", v.MakeVector(v.ColSpace, 1, 2)}, "x*[1; 2]"},
+	}
+
+	for _, testCase := range testCases {
+		function := MakeFuncPanic(regVars, testCase.inputs...)
+		if s, err := function.Infix("x", "y"); err != nil || s != testCase.expected {
+			t.Errorf("Expected %q, received %q, %v", testCase.expected, s, err)
+		}
+	}
+
+	function := MakeFuncPanic(regVars, x, "*", y)
+	if function.String() != "x1*x2" {
+		t.Errorf("Expected %q, received %q", "x1*x2", function.String())
+	}
+	if s := MakeFuncPanic([]args.Var{x}, "Cos", "(", x, ")").String(); s != "Cos(x)" {
+		t.Errorf("Expected %q, received %q", "Cos(x)", s)
+	}
+	if _, err := function.Infix("x"); err == nil {
+		t.Error("Expected error for missing names")
+	}
+}
+
+func TestLaTeX(t *testing.T) {
+	x := args.NewVar(args.Value)
+	y := args.NewVar(args.Value)
+	regVars := []args.Var{x, y}
+
+	testCases := []struct {
+		inputs   []interface{}
+		expected string
+	}{
+		{[]interface{}{"Sin", "(", x, ")", "^", 2}, `\sin\left(x\right)^{2}`},
+		{[]interface{}{"(", x, "+", 1, ")", "/", "(", y, "-", 1, ")"}, `\frac{x + 1}{y - 1}`},
+		{[]interface{}{"(", x, "/", y, ")", "^", "(", x, "+", 1, ")"}, `\left(\frac{x}{y}\right)^{x + 1}`},
+		{[]interface{}{"(", x, "+", y, ")", "*", "Sqrt", "(", y, ")"}, `\left(x + y\right) \cdot \sqrt{y}`},
+		{[]interface{}{"Conj", "(", x, ")", "*", "Asinh", "(", y, ")"}, `\overline{x} \cdot \operatorname{arsinh}\left(y\right)`},
+		{[]interface{}{v.MakeVector(v.ColSpace, 1, 2), "*", x}, `\begin{bmatrix}1 \\ 2\end{bmatrix} \cdot x`},
+	}
+
+	for _, testCase := range testCases {
+		function := MakeFuncPanic(regVars, testCase.inputs...)
+		if s, err := function.LaTeX("x", "y"); err != nil || s != testCase.expected {
+			t.Errorf("Expected %q, received %q, %v", testCase.expected, s, err)
+		}
+	}
+
+	alpha := MakeFuncPanic(regVars, x, "*", y)
+	if s, _ := alpha.LaTeX(`\alpha`, `\beta_{0}`); s != `\alpha \cdot \beta_{0}` {
+		t.Errorf("Expected %q, received %q", `\alpha \cdot \beta_{0}`, s)
+	}
+}
+
+func TestMathML(t *testing.T) {
+	x := args.NewVar(args.Value)
+	y := args.NewVar(args.Value)
+	regVars := []args.Var{x, y}
+
+	testCases := []struct {
+		inputs   []interface{}
+		expected string
+	}{
+		{[]interface{}{x, "^", 2}, "<msup><mrow><mi>x</mi></mrow><mrow><mn>2</mn></mrow></msup>"},
+		{[]interface{}{x, "/", y}, "<mfrac><mrow><mi>x</mi></mrow><mrow><mi>y</mi></mrow></mfrac>"},
+		{[]interface{}{"(", x, "-", y, ")", "*", -1}, "<mrow><mrow><mo>(</mo><mrow><mi>x</mi><mo>-</mo><mi>y</mi></mrow><mo>)</mo></mrow>" +
+			"<mo>&#x22C5;</mo><mrow><mo>(</mo><mo>-</mo><mn>1</mn><mo>)</mo></mrow></mrow>"},
+		{[]interface{}{"Sin", "(", x, ")"}, "<mrow><mi>sin</mi><mo>&#x2061;</mo><mrow><mo>(</mo><mi>x</mi><mo>)</mo></mrow></mrow>"},
+	}
+
+	for _, testCase := range testCases {
+		function := MakeFuncPanic(regVars, testCase.inputs...)
+		expected := `<math xmlns="http://www.w3.org/1998/Math/MathML">` + testCase.expected + "</math>"
+		if s, err := function.MathML("x", "y"); err != nil || s != expected {
+			t.Errorf("Expected %q, received %q, %v", expected, s, err)
+		}
+	}
+
+	function := MakeFuncPanic(regVars, x, "+", y)
+	if s, _ := function.MathML("a<b", "c"); s != `<math xmlns="http://www.w3.org/1998/Math/MathML"><mrow><mi>a&lt;b</mi><mo>+</mo><mi>c</mi></mrow></math>` {
+		t.Errorf("Expected the name to be escaped, received %q", s)
+	}
+}
@@ -0,0 +1,248 @@
+package functions
+
+import (
+	"reflect"
+
+	args "github.com/NumberXNumbers/types/gc/functions/arguments"
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	gcvops "github.com/NumberXNumbers/types/gc/values/ops"
+)
+
+// Simplify returns a new Function that evaluates to the same results as f, up to rounding.
+// Operations on constants are folded into a single constant, the identities x*1, x+0, x-0, x/1
+// and x^1 are removed, Conj(Conj(x)) and -(-x) become x, Value constants of a product are multiplied
+// together at its front and like terms of a sum, such as 2*x + x*3, are combined into 5*x.
+// When every term cancels, such as x - x, 0*x is left, which keeps the shape of a Vector or Matrix x.
+// Operations on constants that fail, such as a division by zero, are kept so that Eval still fails.
+// Returns error if the Args of f do not form an expression
+func (f *Function) Simplify() (*Function, error) {
+	root, err := f.tree()
+	if err != nil {
+		return nil, err
+	}
+	return fromTree(f.registry, f.regVars, f.simplify(root)), nil
+}
+
+// MustSimplify is the same as Simplify but will panic
+func (f *Function) MustSimplify() *Function {
+	function, err := f.Simplify()
+	if err != nil {
+		panic(err)
+	}
+	return function
+}
+
+// simplify returns the simplified tree of n. Trees are never changed, simplified nodes are new
+func (f *Function) simplify(n *node) *node {
+	if n.operation == "" {
+		return n
+	}
+	operands := make([]*node, len(n.operands))
+	for i, operand := range n.operands {
+		operands[i] = f.simplify(operand)
+	}
+	n = opNode(n.operation, operands...)
+	if folded, ok := f.fold(n); ok {
+		return folded
+	}
+
+	switch n.operation {
+	case "Conj", neg:
+		if operands[0].operation == n.operation {
+			return operands[0].operands[0]
+		}
+	case pow, "/":
+		if isValue(operands[1], 1) {
+			return operands[0]
+		}
+	case "*":
+		coefficient, factors := factorsOf(n)
+		return product(coefficient, factors)
+	case "+", "-":
+		return sum(n)
+	}
+	return n
+}
+
+// fold returns the constant result of n if every operand of n is a constant
+func (f *Function) fold(n *node) (*node, bool) {
+	operands := make([]args.Const, len(n.operands))
+	for i, operand := range n.operands {
+		if !operand.isConst() {
+			return nil, false
+		}
+		operands[i] = operand.constant
+	}
+
+	o, ok := f.registry.lookup(n.operation)
+	if !ok {
+		return nil, false
+	}
+	result, err := o.eval(operands...)
+	if err != nil || result == nil {
+		return nil, false
+	}
+	return constNode(result), true
+}
+
+// valueOf returns the Value of a Value constant leaf
+func valueOf(n *node) (gcv.Value, bool) {
+	if !n.isConst() || n.constant.Type() != args.Value {
+		return nil, false
+	}
+	return n.constant.Value(), true
+}
+
+// isValue returns true if n is a Real or Complex constant equal to x
+func isValue(n *node, x complex128) bool {
+	value, ok := valueOf(n)
+	return ok && isNumber(value, x)
+}
+
+// isNumber returns true if value is a Real or Complex Value equal to x
+func isNumber(value gcv.Value, x complex128) bool {
+	return value.Type() <= gcv.Complex && value.Complex() == x
+}
+
+// isNegative returns true if value is a negative Real Value
+func isNegative(value gcv.Value) bool { return value.Type() == gcv.Real && value.Real() < 0 }
+
+// factorsOf returns the product of the Real and Complex constants of the product n and its other
+// factors in order. Other Values, such as Quaternions, do not commute so they are kept as factors
+func factorsOf(n *node) (gcv.Value, []*node) {
+	coefficient := gcv.MakeValue(1)
+	var factors []*node
+	var collect func(n *node)
+	collect = func(n *node) {
+		if n.operation == "*" {
+			collect(n.operands[0])
+			collect(n.operands[1])
+		} else if value, ok := valueOf(n); ok && value.Type() <= gcv.Complex {
+			coefficient = gcvops.Mult(coefficient, value)
+		} else {
+			factors = append(factors, n)
+		}
+	}
+	collect(n)
+	return coefficient, factors
+}
+
+// product returns the tree of coefficient times factors, leaving out a coefficient of 1.
+// Real and Complex Values commute with every factor, so the coefficient is always first
+func product(coefficient gcv.Value, factors []*node) *node {
+	if len(factors) == 0 {
+		return constNode(args.MakeConst(coefficient))
+	}
+	result := factors[0]
+	if !isNumber(coefficient, 1) {
+		result = opNode("*", constNode(args.MakeConst(coefficient)), result)
+	}
+	for _, factor := range factors[1:] {
+		result = opNode("*", result, factor)
+	}
+	return result
+}
+
+// term is one term of a sum, coefficient times the product of factors
+type term struct {
+	coefficient gcv.Value
+	factors     []*node
+}
+
+// sum returns the tree of the sum n with its Value constants added together and its like terms combined
+func sum(n *node) *node {
+	constant := gcv.Zero()
+	var terms []*term
+	var collect func(n *node, sign gcv.Value)
+	collect = func(n *node, sign gcv.Value) {
+		switch n.operation {
+		case "+":
+			collect(n.operands[0], sign)
+			collect(n.operands[1], sign)
+			return
+		case "-":
+			collect(n.operands[0], sign)
+			collect(n.operands[1], gcvops.Mult(gcv.MakeValue(-1), sign))
+			return
+		}
+		if value, ok := valueOf(n); ok {
+			constant = gcvops.Add(constant, gcvops.Mult(sign, value))
+			return
+		}
+		coefficient, factors := factorsOf(n)
+		coefficient = gcvops.Mult(sign, coefficient)
+		for _, t := range terms {
+			if sameFactors(t.factors, factors) {
+				t.coefficient = gcvops.Add(t.coefficient, coefficient)
+				return
+			}
+		}
+		terms = append(terms, &term{coefficient, factors})
+	}
+	collect(n, gcv.MakeValue(1))
+
+	// terms that cancel are dropped, unless all of them do, as 0*x keeps the shape of x
+	var kept []*term
+	for _, t := range terms {
+		if !t.coefficient.IsZero() {
+			kept = append(kept, t)
+		}
+	}
+	if len(kept) == 0 && len(terms) > 0 {
+		kept = terms[:1]
+	}
+	terms = kept
+
+	var result *node
+	for _, t := range terms {
+		switch {
+		case result == nil:
+			result = product(t.coefficient, t.factors)
+		case isNegative(t.coefficient):
+			result = opNode("-", result, product(gcvops.Mult(gcv.MakeValue(-1), t.coefficient), t.factors))
+		default:
+			result = opNode("+", result, product(t.coefficient, t.factors))
+		}
+	}
+	switch {
+	case result == nil:
+		return constNode(args.MakeConst(constant))
+	case constant.IsZero():
+		return result
+	case isNegative(constant):
+		return opNode("-", result, constNode(args.MakeConst(gcvops.Mult(gcv.MakeValue(-1), constant))))
+	}
+	return opNode("+", result, constNode(args.MakeConst(constant)))
+}
+
+// sameFactors returns true if both lists of factors are the same trees in the same order
+func sameFactors(factorsA []*node, factorsB []*node) bool {
+	if len(factorsA) != len(factorsB) {
+		return false
+	}
+	for i := range factorsA {
+		if !sameTree(factorsA[i], factorsB[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// sameTree returns true if a and b are the same expression
+func sameTree(a *node, b *node) bool {
+	switch {
+	case a.isConst() || b.isConst():
+		if !a.isConst() || !b.isConst() {
+			return false
+		}
+		valueA, okA := valueOf(a)
+		valueB, okB := valueOf(b)
+		if okA && okB && valueA.Type() <= gcv.Complex && valueB.Type() <= gcv.Complex {
+			return valueA.Complex() == valueB.Complex()
+		}
+		return reflect.DeepEqual(a.constant, b.constant)
+	case a.variable != nil || b.variable != nil:
+		return a.variable == b.variable
+	}
+	return a.operation == b.operation && sameFactors(a.operands, b.operands)
+}
@@ -0,0 +1,90 @@
+package functions
+
+import (
+	"testing"
+
+	args "github.com/NumberXNumbers/types/gc/functions/arguments"
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	gcvops "github.com/NumberXNumbers/types/gc/values/ops"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+func TestSimplify(t *testing.T) {
+	x := args.NewVar(args.Value)
+	y := args.NewVar(args.Value)
+	regVars := []args.Var{x, y}
+	q := gcv.MakeQuaternion(0, 0, 1, 0)
+
+	testCases := []struct {
+		inputs  []interface{}
+		numArgs int
+	}{
+		{[]interface{}{2, "*", 3, "*", x}, 3},
+		{[]interface{}{x, "*", 1}, 1},
+		{[]interface{}{1, "*", x, "+", 0}, 1},
+		{[]interface{}{x, "-", 0}, 1},
+		{[]interface{}{x, "/", 1}, 1},
+		{[]interface{}{x, "^", 1}, 1},
+		{[]interface{}{"Conj", "(", "Conj", "(", x, ")", ")"}, 1},
+		{[]interface{}{2, "*", x, "+", x, "*", 3}, 3},
+		{[]interface{}{2, "*", x, "*", 3}, 3},
+		{[]interface{}{x, "+", y, "-", x, "+", 4, "-", 1}, 3},
+		{[]interface{}{x, "-", x}, 3},
+		{[]interface{}{x, "*", y, "-", 3, "*", x, "*", y, "+", y}, 7},
+		{[]interface{}{"Sin", "(", 0, ")", "+", x}, 1},
+		{[]interface{}{x, "-", 2, "*", x}, 3},
+		{[]interface{}{x, "*", q}, 3},
+		{[]interface{}{x, "*", q, "+", q, "*", x}, 7},
+		{[]interface{}{2, "*", q, "*", x, "*", 3}, 5},
+		{[]interface{}{x, "*", q, "-", x, "*", q}, 5},
+	}
+
+	for index, testCase := range testCases {
+		function := MakeFuncPanic(regVars, testCase.inputs...)
+		simplified := function.MustSimplify()
+		if len(simplified.Args) != testCase.numArgs {
+			t.Errorf("Test case %d: expected %d args, received %v", index, testCase.numArgs, simplified.Args)
+		}
+		for _, inputs := range [][]interface{}{{2, 3}, {-1.5, 0.25}, {1 + 2i, 3 - 1i},
+			{gcv.MakeQuaternion(0, 1, 0, 0), gcv.MakeQuaternion(1, 2, 3, 4)}} {
+			expected := function.MustEval(inputs...).Value()
+			received := simplified.MustEval(inputs...).Value()
+			if gcvops.Abs(gcvops.Sub(expected, received)).Real() > 1e-12 {
+				t.Errorf("Test case %d: expected %v, received %v", index, expected, received)
+			}
+		}
+	}
+}
+
+func TestSimplifyKeepsOrderOfMatrices(t *testing.T) {
+	a := args.NewVar(args.Matrix)
+	b := args.NewVar(args.Matrix)
+	function := MakeFuncPanic([]args.Var{a, b}, a, "*", 2, "*", b, "+", b, "*", a)
+	simplified := function.MustSimplify()
+
+	matrixA := m.MakeMatrix(v.MakeVector(v.RowSpace, 1, 2), v.MakeVector(v.RowSpace, 3, 4))
+	matrixB := m.MakeMatrix(v.MakeVector(v.RowSpace, 0, 1), v.MakeVector(v.RowSpace, 1, 0))
+	expected := function.MustEval(matrixA, matrixB).Matrix()
+	received := simplified.MustEval(matrixA, matrixB).Matrix()
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			if expected.Get(i, j).Complex() != received.Get(i, j).Complex() {
+				t.Errorf("Expected %v, received %v", expected.Elements(), received.Elements())
+			}
+		}
+	}
+}
+
+func TestSimplifyKeepsFailingConstants(t *testing.T) {
+	x := args.NewVar(args.Value)
+	function := MakeFuncPanic([]args.Var{x}, x, "+", v.MakeVector(v.RowSpace, 1), "*", m.NewMatrix(2, 2))
+	simplified := function.MustSimplify()
+	if _, err := simplified.Eval(1); err == nil {
+		t.Error("Expected error")
+	}
+
+	if _, err := MakeFuncPanic([]args.Var{x}, x, x).Simplify(); err == nil {
+		t.Error("Expected error for left over operands")
+	}
+}
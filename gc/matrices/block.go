@@ -0,0 +1,127 @@
+package matrices
+
+import (
+	"fmt"
+
+	gcv "github.com/NumberXNumbers/types/gc/values"
+)
+
+// blockSizes returns the number of rows of each row of blocks and the number of columns
+// of each column of blocks. Returns error if the blocks do not line up
+func blockSizes(blocks [][]Matrix) (rows []int, cols []int, err error) {
+	if len(blocks) == 0 {
+		return nil, nil, fmt.Errorf("Block matrix has no blocks")
+	}
+	rows = make([]int, len(blocks))
+	cols = make([]int, len(blocks[0]))
+	for i := range rows {
+		rows[i] = -1
+	}
+	for j := range cols {
+		cols[j] = -1
+	}
+
+	for i, blockRow := range blocks {
+		if len(blockRow) != len(cols) {
+			return nil, nil, fmt.Errorf("Row %d of blocks has %d blocks, not %d", i, len(blockRow), len(cols))
+		}
+		for j, block := range blockRow {
+			if block == nil {
+				continue
+			}
+			blockRows, blockCols := block.Dim()
+			if rows[i] >= 0 && rows[i] != blockRows {
+				return nil, nil, fmt.Errorf("Block (%d, %d) has %d rows, not %d", i, j, blockRows, rows[i])
+			}
+			if cols[j] >= 0 && cols[j] != blockCols {
+				return nil, nil, fmt.Errorf("Block (%d, %d) has %d columns, not %d", i, j, blockCols, cols[j])
+			}
+			rows[i], cols[j] = blockRows, blockCols
+		}
+	}
+
+	for i, size := range rows {
+		if size < 0 {
+			return nil, nil, fmt.Errorf("Row %d of blocks has no matrix to size it", i)
+		}
+	}
+	for j, size := range cols {
+		if size < 0 {
+			return nil, nil, fmt.Errorf("Column %d of blocks has no matrix to size it", j)
+		}
+	}
+	return rows, cols, nil
+}
+
+// locate returns the block holding index and the index inside that block
+func locate(sizes []int, index int) (int, int) {
+	for block, size := range sizes {
+		if index < size {
+			return block, index
+		}
+		index -= size
+	}
+	return len(sizes), index
+}
+
+// MakeBlockMatrix returns the Matrix made of blocks laid out in a grid, each inner slice a row of blocks.
+// A nil block is a zero matrix sized by the other blocks in its row and column.
+// The blocks are not copied until the block matrix is first set, after which changes to
+// either the block matrix or the blocks do not affect the other.
+// Returns error if the blocks in a row do not have the same number of rows, the blocks in a
+// column do not have the same number of columns, or a row or column of blocks is all nil
+func MakeBlockMatrix(blocks ...[]Matrix) (Matrix, error) {
+	rows, cols, err := blockSizes(blocks)
+	if err != nil {
+		return nil, err
+	}
+	grid := make([][]Matrix, len(blocks))
+	for i := range blocks {
+		grid[i] = append([]Matrix{}, blocks[i]...)
+	}
+
+	numRows, numCols := 0, 0
+	for _, size := range rows {
+		numRows += size
+	}
+	for _, size := range cols {
+		numCols += size
+	}
+
+	// owned is nil until the first write, from then on the block matrix owns its elements
+	var owned Matrix
+	get := func(row, col int) gcv.Value {
+		if owned != nil {
+			return owned.Get(row, col)
+		}
+		i, blockRow := locate(rows, row)
+		j, blockCol := locate(cols, col)
+		if grid[i][j] == nil {
+			return gcv.Zero()
+		}
+		return grid[i][j].Get(blockRow, blockCol)
+	}
+	set := func(row, col int, value gcv.Value) {
+		if owned == nil {
+			copied := NewMatrix(numRows, numCols)
+			for i := 0; i < numRows; i++ {
+				for j := 0; j < numCols; j++ {
+					copied.Set(i, j, get(i, j))
+				}
+			}
+			owned = copied
+		}
+		owned.Set(row, col, value)
+	}
+	block := &lazyMatrix{numRows: numRows, numCols: numCols, get: get, set: set}
+	return block, nil
+}
+
+// MustMakeBlockMatrix is the same as MakeBlockMatrix but will panic
+func MustMakeBlockMatrix(blocks ...[]Matrix) Matrix {
+	matrix, err := MakeBlockMatrix(blocks...)
+	if err != nil {
+		panic(err)
+	}
+	return matrix
+}
@@ -0,0 +1,65 @@
+package matrices
+
+import (
+	"reflect"
+	"testing"
+
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+func TestMakeBlockMatrix(t *testing.T) {
+	a := MakeMatrix(v.MakeVector(v.RowSpace, 1, 2), v.MakeVector(v.RowSpace, 3, 4))
+	b := MakeMatrix(v.MakeVector(v.RowSpace, 5), v.MakeVector(v.RowSpace, 6))
+	c := MakeMatrix(v.MakeVector(v.RowSpace, 7))
+
+	block, err := MakeBlockMatrix([]Matrix{a, b}, []Matrix{nil, c})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := MakeMatrix(
+		v.MakeVector(v.RowSpace, 1, 2, 5),
+		v.MakeVector(v.RowSpace, 3, 4, 6),
+		v.MakeVector(v.RowSpace, 0, 0, 7))
+	if !reflect.DeepEqual(block.Copy(), expected) {
+		t.Errorf("Expected %v, received %v", expected, block.Copy())
+	}
+
+	a.Set(0, 0, 10)
+	if block.Get(0, 0).Real() != 10 {
+		t.Errorf("Expected the block matrix to share a, received %v", block.Get(0, 0))
+	}
+
+	block.Trans()
+	block.Set(2, 1, 60)
+	if block.Get(2, 1).Real() != 60 || block.Get(0, 0).Real() != 10 || block.Get(2, 2).Real() != 7 {
+		t.Errorf("Expected the transpose with (2, 1) set, received %v", block.Copy())
+	}
+	if b.Get(1, 0).Real() != 6 {
+		t.Errorf("Expected b to be unchanged, received %v", b.Get(1, 0))
+	}
+
+	a.Set(1, 1, 40)
+	if block.Get(1, 1).Real() != 4 {
+		t.Errorf("Expected the block matrix to own its elements, received %v", block.Get(1, 1))
+	}
+}
+
+func TestMakeBlockMatrixErrors(t *testing.T) {
+	a := NewMatrix(2, 2)
+	b := NewMatrix(3, 1)
+
+	testCases := [][][]Matrix{
+		{},
+		{{a, b}},
+		{{a}, {b}},
+		{{a, nil}, {nil, nil}},
+		{{a, a}, {a}},
+	}
+
+	for index, blocks := range testCases {
+		if _, err := MakeBlockMatrix(blocks...); err == nil {
+			t.Errorf("Test case %d: expected error", index)
+		}
+	}
+}
@@ -76,6 +76,9 @@ type Matrix interface {
 	// left are columns to cut from the left.
 	// right are columns to cut from the right.
 	Trim(top, bottom, left, right int) Matrix
+
+	// Returns the reduced row echelon form of Matrix, see RREFTol
+	RREF() Matrix
 }
 
 type matrix struct {
@@ -278,7 +281,7 @@ func (m *matrix) Aug(b interface{}) Matrix {
 				if j < colsA {
 					augmentedMatrix.Set(i, j, m.Get(i, j))
 				} else {
-					augmentedMatrix.Set(i, j, vector.Get(j-colsA))
+					augmentedMatrix.Set(i, j, vector.Get(i))
 				}
 			}
 		}
@@ -307,6 +310,12 @@ func (m *matrix) Trim(top, bottom, left, right int) Matrix {
 	return subMatrix
 }
 
+// implementation of RREF method
+func (m *matrix) RREF() Matrix {
+	rref, _ := RREFTol(m, DefaultTolerance)
+	return rref
+}
+
 func (m *matrix) Inv() (Matrix, error) {
 	if !m.IsSquare() {
 		return nil, errors.New("Matrix is not square")
@@ -437,7 +446,9 @@ func Print(m Matrix) {
 	rows, cols := m.Dim()
 	for i := 0; i < rows; i++ {
 		for j := 0; j < cols; j++ {
-			if m.Type() == gcv.Complex {
+			if m.Type() != gcv.Real && m.Type() != gcv.Complex {
+				fmt.Printf("%v ", m.Get(i, j))
+			} else if m.Type() == gcv.Complex {
 				fmt.Printf("%v ", m.Get(i, j).Complex())
 			} else {
 				fmt.Printf("%f ", m.Get(i, j).Real())
@@ -346,6 +346,12 @@ func TestAug(t *testing.T) {
 	if !reflect.DeepEqual(solutionMatrixB, resultMatrixABb) {
 		t.Errorf("Expected %v, received %v", solutionMatrixB, resultMatrixABb)
 	}
+	resultMatrixABc := testMatrixA.Aug(v.MakeVector(v.ColSpace, 4, 5, 6))
+	for i := 0; i < 3; i++ {
+		if resultMatrixABc.Get(i, 3).Real() != float64(i+4) {
+			t.Errorf("Expected %v, received %v", i+4, resultMatrixABc.Get(i, 3))
+		}
+	}
 }
 
 func TestArgPanicMatrixRowsIncorrect(t *testing.T) {
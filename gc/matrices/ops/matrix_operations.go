@@ -91,8 +91,14 @@ func MustMVMult(vector v.Vector, matrix m.Matrix) v.Vector {
 	return newVector
 }
 
-// MultSimple is an operation that will multiple two m of any size (m X k) and (k X n) together
+// MultSimple is an operation that will multiple two m of any size (m X k) and (k X n) together.
+// Products where every dimension is at least DefaultMultConfig.Threshold are handed off to MultBlocked
 func MultSimple(matrixA m.Matrix, matrixB m.Matrix) (m.Matrix, error) {
+	return multSimple(matrixA, matrixB, DefaultMultConfig)
+}
+
+// multSimple is MultSimple handing large products off to MultBlocked with config
+func multSimple(matrixA m.Matrix, matrixB m.Matrix, config MultConfig) (m.Matrix, error) {
 	if matrixA.GetNumCols() != matrixB.GetNumRows() {
 		return nil, errors.New("Length of columns of matrix A not equal to length of rows of matrix B")
 	}
@@ -108,6 +114,10 @@ func MultSimple(matrixA m.Matrix, matrixB m.Matrix) (m.Matrix, error) {
 		return matrixAB, nil
 	}
 
+	if useBlocked(matrixA, matrixB, config) {
+		return MultBlocked(matrixA, matrixB, config)
+	}
+
 	matrixAB = m.NewMatrix(matrixA.GetNumRows(), matrixB.GetNumCols())
 	var sum gcv.Value
 	for i := 0; i < matrixA.GetNumRows(); i++ {
@@ -131,22 +141,38 @@ func MustMultSimple(matrixA m.Matrix, matrixB m.Matrix) m.Matrix {
 	return matrixAB
 }
 
-// Add is an operation that will add two m together
-func Add(matrixA m.Matrix, matrixB m.Matrix) (m.Matrix, error) {
-	if matrixA.GetNumCols() != matrixB.GetNumCols() || matrixA.GetNumRows() != matrixB.GetNumRows() {
-		return nil, errors.New("Matrices do not have equivalent dimensions")
-	}
-
+// elementwise returns op of the elements of two Matrices of the same size.
+// If either Matrix holds a Quantity quantityOp is used, so Values of different Dimensions return error
+func elementwise(matrixA m.Matrix, matrixB m.Matrix, op func(gcv.Value, gcv.Value) gcv.Value,
+	quantityOp func(gcv.Value, gcv.Value) (gcv.Value, error)) (m.Matrix, error) {
 	matrixAB := m.NewMatrix(matrixA.GetNumRows(), matrixB.GetNumCols())
-
+	quantity := matrixA.Type() == gcv.Quantity || matrixB.Type() == gcv.Quantity
 	for i := 0; i < matrixA.GetNumRows(); i++ {
 		for j := 0; j < matrixA.GetNumCols(); j++ {
-			matrixAB.Set(i, j, gcvops.Add(matrixA.Get(i, j), matrixB.Get(i, j)))
+			if !quantity {
+				matrixAB.Set(i, j, op(matrixA.Get(i, j), matrixB.Get(i, j)))
+				continue
+			}
+			value, err := quantityOp(matrixA.Get(i, j), matrixB.Get(i, j))
+			if err != nil {
+				return nil, err
+			}
+			matrixAB.Set(i, j, value)
 		}
 	}
 	return matrixAB, nil
 }
 
+// Add is an operation that will add two m together.
+// Returns error if the Matrices are not the same size or hold Quantities of different Dimensions
+func Add(matrixA m.Matrix, matrixB m.Matrix) (m.Matrix, error) {
+	if matrixA.GetNumCols() != matrixB.GetNumCols() || matrixA.GetNumRows() != matrixB.GetNumRows() {
+		return nil, errors.New("Matrices do not have equivalent dimensions")
+	}
+
+	return elementwise(matrixA, matrixB, gcvops.Add, gcvops.AddQuantity)
+}
+
 // MustAdd is the same as Add, but will panic
 func MustAdd(matrixA m.Matrix, matrixB m.Matrix) m.Matrix {
 	matrixAB, err := Add(matrixA, matrixB)
@@ -156,20 +182,14 @@ func MustAdd(matrixA m.Matrix, matrixB m.Matrix) m.Matrix {
 	return matrixAB
 }
 
-// Sub is an operation that will subtract two m from one another
+// Sub is an operation that will subtract two m from one another.
+// Returns error if the Matrices are not the same size or hold Quantities of different Dimensions
 func Sub(matrixA m.Matrix, matrixB m.Matrix) (m.Matrix, error) {
 	if matrixA.GetNumCols() != matrixB.GetNumCols() || matrixA.GetNumRows() != matrixB.GetNumRows() {
 		return nil, errors.New("Matrices do not have equivalent dimensions")
 	}
 
-	matrixAB := m.NewMatrix(matrixA.GetNumRows(), matrixB.GetNumCols())
-
-	for i := 0; i < matrixA.GetNumRows(); i++ {
-		for j := 0; j < matrixA.GetNumCols(); j++ {
-			matrixAB.Set(i, j, gcvops.Sub(matrixA.Get(i, j), matrixB.Get(i, j)))
-		}
-	}
-	return matrixAB, nil
+	return elementwise(matrixA, matrixB, gcvops.Sub, gcvops.SubQuantity)
 }
 
 // MustSub is the same as Sub, but will panic
@@ -181,36 +201,42 @@ func MustSub(matrixA m.Matrix, matrixB m.Matrix) m.Matrix {
 	return matrixAB
 }
 
-func squareAndMultiplyHelper(matrixA, matrixB m.Matrix, n int) (m.Matrix, error) {
+func squareAndMultiplyHelper(matrixA, matrixB m.Matrix, n int, config MultConfig) (m.Matrix, error) {
 	if n < 0 {
 		newMatrix, err := matrixB.Copy().Inv()
 		if err != nil {
 			return nil, err
 		}
-		return squareAndMultiplyHelper(matrixA, newMatrix, -n)
+		return squareAndMultiplyHelper(matrixA, newMatrix, -n, config)
 	} else if n == 0 {
 		return matrixA, nil
 	} else if n == 1 {
-		return MultSimple(matrixA, matrixB)
+		return multSimple(matrixA, matrixB, config)
 	} else if n%2 == 0 {
-		newMatrix, _ := MultSimple(matrixB, matrixB)
-		return squareAndMultiplyHelper(matrixA, newMatrix, n/2)
+		newMatrix, _ := multSimple(matrixB, matrixB, config)
+		return squareAndMultiplyHelper(matrixA, newMatrix, n/2, config)
 	}
 
-	newMatrixA, _ := MultSimple(matrixB, matrixA)
-	newMatrixB, _ := MultSimple(matrixB, matrixB)
-	return squareAndMultiplyHelper(newMatrixA, newMatrixB, (n-1)/2)
+	newMatrixA, _ := multSimple(matrixB, matrixA, config)
+	newMatrixB, _ := multSimple(matrixB, matrixB, config)
+	return squareAndMultiplyHelper(newMatrixA, newMatrixB, (n-1)/2, config)
 }
 
 // squareAndMultiply will solve the power of a matrix by squaring and multiplying
-func squareAndMultply(matrix m.Matrix, n int) (m.Matrix, error) {
+func squareAndMultply(matrix m.Matrix, n int, config MultConfig) (m.Matrix, error) {
 	degree, _ := matrix.Dim()
 	identityMatrix := m.NewIdentityMatrix(degree)
-	return squareAndMultiplyHelper(identityMatrix, matrix, n)
+	return squareAndMultiplyHelper(identityMatrix, matrix, n, config)
 }
 
-// Pow is an operation that will raise a square Matrix to int n
+// Pow is an operation that will raise a square Matrix to int n.
+// Large matrices are multiplied with MultBlocked, see MultSimple
 func Pow(matrix m.Matrix, n int) (m.Matrix, error) {
+	return pow(matrix, n, DefaultMultConfig)
+}
+
+// pow is Pow multiplying with config
+func pow(matrix m.Matrix, n int, config MultConfig) (m.Matrix, error) {
 	if !matrix.IsSquare() {
 		return nil, errors.New("Matrix is not square")
 	}
@@ -219,7 +245,7 @@ func Pow(matrix m.Matrix, n int) (m.Matrix, error) {
 		return matrix, nil
 	}
 
-	return squareAndMultply(matrix, n)
+	return squareAndMultply(matrix, n, config)
 }
 
 // MustPow is the same as Pow, but wil panic
@@ -28,3 +28,29 @@ func BenchmarkVMMult(b *testing.B) {
 		VMMult(testVectorA, testMatrix)
 	}
 }
+
+func BenchmarkMultSimple(b *testing.B) {
+	testMatrix := m.NewMatrix(64, 64)
+	for i := 0; i < 64; i++ {
+		for j := 0; j < 64; j++ {
+			testMatrix.Set(i, j, gcv.MakeValue(i+j))
+		}
+	}
+
+	for n := 0; n < b.N; n++ {
+		multSimple(testMatrix, testMatrix, MultConfig{})
+	}
+}
+
+func BenchmarkMultBlocked(b *testing.B) {
+	testMatrix := m.NewMatrix(64, 64)
+	for i := 0; i < 64; i++ {
+		for j := 0; j < 64; j++ {
+			testMatrix.Set(i, j, gcv.MakeValue(i+j))
+		}
+	}
+
+	for n := 0; n < b.N; n++ {
+		MultBlocked(testMatrix, testMatrix, DefaultMultConfig)
+	}
+}
@@ -0,0 +1,305 @@
+package mops
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	gcv "github.com/NumberXNumbers/types/gc/values"
+)
+
+// MultConfig holds the settings used by MultBlocked
+type MultConfig struct {
+	// BlockSize is the edge length of the square tiles the matrices are cut into
+	BlockSize int
+
+	// Workers is the number of goroutines that the row tiles are spread across
+	Workers int
+
+	// Threshold is the smallest dimension at which MultSimple and Pow will switch to MultBlocked.
+	// A Threshold less than 1 means MultSimple never switches.
+	Threshold int
+
+	// StrassenThreshold is the size above which Strassen's algorithm is used. Sub products
+	// at or below this size are done with the blocked algorithm. A StrassenThreshold less
+	// than 1 turns Strassen's algorithm off.
+	StrassenThreshold int
+}
+
+// DefaultMultConfig is the MultConfig used by MultSimple and Pow
+var DefaultMultConfig = MultConfig{
+	BlockSize:         64,
+	Workers:           runtime.NumCPU(),
+	Threshold:         64,
+	StrassenThreshold: 0,
+}
+
+// MultBlocked will multiply two matrices of size (m X k) and (k X n) together by cutting them
+// into tiles and spreading the rows of tiles across a pool of goroutines.
+// Only matrices with Real or Complex Values are supported, else error.
+func MultBlocked(matrixA m.Matrix, matrixB m.Matrix, config MultConfig) (m.Matrix, error) {
+	if matrixA.GetNumCols() != matrixB.GetNumRows() {
+		return nil, errors.New("Length of columns of matrix A not equal to length of rows of matrix B")
+	}
+
+	if !isDense(matrixA) || !isDense(matrixB) {
+		return nil, errors.New("Matrix type is not supported for blocked multiplication")
+	}
+
+	if config.BlockSize < 1 {
+		config.BlockSize = DefaultMultConfig.BlockSize
+	}
+
+	if config.Workers < 1 {
+		config.Workers = 1
+	}
+
+	rows, inner := matrixA.Dim()
+	cols := matrixB.GetNumCols()
+	matrixAB := m.NewMatrix(rows, cols)
+
+	if matrixA.Type() != gcv.Complex && matrixB.Type() != gcv.Complex {
+		product := realProduct(realParts(matrixA), realParts(matrixB), rows, inner, cols, config)
+		for i := 0; i < rows; i++ {
+			for j := 0; j < cols; j++ {
+				matrixAB.Set(i, j, product[i*cols+j])
+			}
+		}
+		return matrixAB, nil
+	}
+
+	realA, imagA := realParts(matrixA), imagParts(matrixA)
+	realB, imagB := realParts(matrixB), imagParts(matrixB)
+
+	// (Ar + iAi)(Br + iBi) = (ArBr - AiBi) + i(ArBi + AiBr)
+	realAB := subDense(
+		realProduct(realA, realB, rows, inner, cols, config),
+		realProduct(imagA, imagB, rows, inner, cols, config))
+	imagAB := addDense(
+		realProduct(realA, imagB, rows, inner, cols, config),
+		realProduct(imagA, realB, rows, inner, cols, config))
+
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			matrixAB.Set(i, j, complex(realAB[i*cols+j], imagAB[i*cols+j]))
+		}
+	}
+	return matrixAB, nil
+}
+
+// MustMultBlocked is the same as MultBlocked, but will panic
+func MustMultBlocked(matrixA m.Matrix, matrixB m.Matrix, config MultConfig) m.Matrix {
+	matrixAB, err := MultBlocked(matrixA, matrixB, config)
+	if err != nil {
+		panic(err)
+	}
+	return matrixAB
+}
+
+// useBlocked reports whether MultSimple should hand the product off to MultBlocked with config
+func useBlocked(matrixA m.Matrix, matrixB m.Matrix, config MultConfig) bool {
+	threshold := config.Threshold
+	if threshold < 1 || !isDense(matrixA) || !isDense(matrixB) {
+		return false
+	}
+	rows, inner := matrixA.Dim()
+	return rows >= threshold && inner >= threshold && matrixB.GetNumCols() >= threshold
+}
+
+func isDense(matrix m.Matrix) bool {
+	return matrix.Type() == gcv.Real || matrix.Type() == gcv.Complex
+}
+
+func realParts(matrix m.Matrix) []float64 {
+	rows, cols := matrix.Dim()
+	parts := make([]float64, rows*cols)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			parts[i*cols+j] = matrix.Get(i, j).Real()
+		}
+	}
+	return parts
+}
+
+func imagParts(matrix m.Matrix) []float64 {
+	rows, cols := matrix.Dim()
+	parts := make([]float64, rows*cols)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			parts[i*cols+j] = matrix.Get(i, j).Imag()
+		}
+	}
+	return parts
+}
+
+func addDense(a, b []float64) []float64 {
+	c := make([]float64, len(a))
+	for i := range a {
+		c[i] = a[i] + b[i]
+	}
+	return c
+}
+
+func subDense(a, b []float64) []float64 {
+	c := make([]float64, len(a))
+	for i := range a {
+		c[i] = a[i] - b[i]
+	}
+	return c
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// realProduct multiplies the row major (rows X inner) a by the row major (inner X cols) b
+func realProduct(a, b []float64, rows, inner, cols int, config MultConfig) []float64 {
+	threshold := config.StrassenThreshold
+	if threshold < 1 || minInt(rows, minInt(inner, cols)) <= threshold {
+		return blockedProduct(a, b, rows, inner, cols, config)
+	}
+
+	size := maxInt(rows, maxInt(inner, cols))
+	product := strassenProduct(padDense(a, rows, inner, size), padDense(b, inner, cols, size), size, config)
+	return cropDense(product, size, rows, cols)
+}
+
+// blockedProduct is the tiled kernel. Every element of the product is summed over k in
+// ascending order, so for real matrices it matches MultSimple exactly.
+func blockedProduct(a, b []float64, rows, inner, cols int, config MultConfig) []float64 {
+	c := make([]float64, rows*cols)
+	blockSize := config.BlockSize
+
+	tiles := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < config.Workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ii := range tiles {
+				iMax := minInt(ii+blockSize, rows)
+				for kk := 0; kk < inner; kk += blockSize {
+					kMax := minInt(kk+blockSize, inner)
+					for jj := 0; jj < cols; jj += blockSize {
+						jMax := minInt(jj+blockSize, cols)
+						for i := ii; i < iMax; i++ {
+							cRow := c[i*cols : (i+1)*cols]
+							for k := kk; k < kMax; k++ {
+								aik := a[i*inner+k]
+								bRow := b[k*cols : (k+1)*cols]
+								for j := jj; j < jMax; j++ {
+									// the explicit conversion stops the compiler from fusing
+									// the multiply and add, which would change the rounding
+									cRow[j] += float64(aik * bRow[j])
+								}
+							}
+						}
+					}
+				}
+			}
+		}()
+	}
+
+	for ii := 0; ii < rows; ii += blockSize {
+		tiles <- ii
+	}
+	close(tiles)
+	wg.Wait()
+	return c
+}
+
+// strassenProduct multiplies two square (size X size) matrices using Strassen's algorithm.
+// Odd sizes are handled by zero padding the quadrants.
+func strassenProduct(a, b []float64, size int, config MultConfig) []float64 {
+	if size <= config.StrassenThreshold || size == 1 {
+		return blockedProduct(a, b, size, size, size, config)
+	}
+
+	half := (size + 1) / 2
+	a11, a12, a21, a22 := quadrants(a, size, half)
+	b11, b12, b21, b22 := quadrants(b, size, half)
+
+	m1 := strassenProduct(addDense(a11, a22), addDense(b11, b22), half, config)
+	m2 := strassenProduct(addDense(a21, a22), b11, half, config)
+	m3 := strassenProduct(a11, subDense(b12, b22), half, config)
+	m4 := strassenProduct(a22, subDense(b21, b11), half, config)
+	m5 := strassenProduct(addDense(a11, a12), b22, half, config)
+	m6 := strassenProduct(subDense(a21, a11), addDense(b11, b12), half, config)
+	m7 := strassenProduct(subDense(a12, a22), addDense(b21, b22), half, config)
+
+	c11 := addDense(subDense(addDense(m1, m4), m5), m7)
+	c12 := addDense(m3, m5)
+	c21 := addDense(m2, m4)
+	c22 := addDense(addDense(subDense(m1, m2), m3), m6)
+
+	c := make([]float64, size*size)
+	for i := 0; i < half; i++ {
+		for j := 0; j < half; j++ {
+			c[i*size+j] = c11[i*half+j]
+			if j+half < size {
+				c[i*size+j+half] = c12[i*half+j]
+			}
+			if i+half < size {
+				c[(i+half)*size+j] = c21[i*half+j]
+				if j+half < size {
+					c[(i+half)*size+j+half] = c22[i*half+j]
+				}
+			}
+		}
+	}
+	return c
+}
+
+// quadrants splits a square (size X size) matrix into four (half X half) matrices,
+// padding with zeros when size is odd
+func quadrants(a []float64, size, half int) (q11, q12, q21, q22 []float64) {
+	q11 = make([]float64, half*half)
+	q12 = make([]float64, half*half)
+	q21 = make([]float64, half*half)
+	q22 = make([]float64, half*half)
+	for i := 0; i < size; i++ {
+		for j := 0; j < size; j++ {
+			value := a[i*size+j]
+			switch {
+			case i < half && j < half:
+				q11[i*half+j] = value
+			case i < half:
+				q12[i*half+j-half] = value
+			case j < half:
+				q21[(i-half)*half+j] = value
+			default:
+				q22[(i-half)*half+j-half] = value
+			}
+		}
+	}
+	return
+}
+
+// padDense copies a (rows X cols) matrix into the top left corner of a (size X size) matrix
+func padDense(a []float64, rows, cols, size int) []float64 {
+	padded := make([]float64, size*size)
+	for i := 0; i < rows; i++ {
+		copy(padded[i*size:i*size+cols], a[i*cols:(i+1)*cols])
+	}
+	return padded
+}
+
+// cropDense returns the top left (rows X cols) corner of a (size X size) matrix
+func cropDense(a []float64, size, rows, cols int) []float64 {
+	cropped := make([]float64, rows*cols)
+	for i := 0; i < rows; i++ {
+		copy(cropped[i*cols:(i+1)*cols], a[i*size:i*size+cols])
+	}
+	return cropped
+}
@@ -0,0 +1,142 @@
+package mops
+
+import (
+	"fmt"
+	"math/cmplx"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+func randomMatrix(r *rand.Rand, rows, cols int, complexValues bool) m.Matrix {
+	matrix := m.NewMatrix(rows, cols)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			if complexValues {
+				matrix.Set(i, j, complex(r.Float64()*10-5, r.Float64()*10-5))
+			} else {
+				matrix.Set(i, j, r.Float64()*10-5)
+			}
+		}
+	}
+	return matrix
+}
+
+// simpleProduct returns the product of matrixA and matrixB without MultBlocked
+func simpleProduct(matrixA, matrixB m.Matrix) m.Matrix {
+	matrixAB, err := multSimple(matrixA, matrixB, MultConfig{})
+	if err != nil {
+		panic(err)
+	}
+	return matrixAB
+}
+
+func matricesClose(matrixA, matrixB m.Matrix, tol float64) bool {
+	rows, cols := matrixA.Dim()
+	if rows != matrixB.GetNumRows() || cols != matrixB.GetNumCols() {
+		return false
+	}
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			if cmplx.Abs(matrixA.Get(i, j).Complex()-matrixB.Get(i, j).Complex()) > tol {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestMultBlocked(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	configs := []MultConfig{
+		{BlockSize: 1, Workers: 1},
+		{BlockSize: 4, Workers: 3},
+		{BlockSize: 16, Workers: 8},
+		{BlockSize: 5, Workers: 2, StrassenThreshold: 3},
+		{BlockSize: 0, Workers: 0, StrassenThreshold: 8},
+	}
+	dims := [][3]int{{1, 1, 1}, {7, 5, 3}, {17, 17, 17}, {20, 33, 9}, {32, 32, 32}}
+
+	for _, complexValues := range []bool{false, true} {
+		for _, dim := range dims {
+			matrixA := randomMatrix(r, dim[0], dim[1], complexValues)
+			matrixB := randomMatrix(r, dim[1], dim[2], complexValues)
+			solution := simpleProduct(matrixA, matrixB)
+			for _, config := range configs {
+				result, err := MultBlocked(matrixA, matrixB, config)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !matricesClose(result, solution, 1e-9) {
+					t.Errorf("Failure: %v X %v with %+v, complex %v", dim, dim[2], config, complexValues)
+				}
+				if complexValues && result.Type() != gcv.Complex {
+					t.Errorf("Expected Complex Matrix, received %v", result.Type())
+				}
+			}
+		}
+	}
+}
+
+func TestMultBlockedExact(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	matrixA := randomMatrix(r, 23, 31, false)
+	matrixB := randomMatrix(r, 31, 19, false)
+
+	result := MustMultBlocked(matrixA, matrixB, MultConfig{BlockSize: 8, Workers: 4})
+	solution := simpleProduct(matrixA, matrixB)
+
+	if !reflect.DeepEqual(result, solution) {
+		t.Error("Expected blocked product of real matrices to match MultSimple exactly")
+	}
+}
+
+func TestMultBlockedErrors(t *testing.T) {
+	testVectorCa := v.MakeVector(v.RowSpace, gcv.MakeValue(2), gcv.MakeValue(0), gcv.MakeValue(1))
+	testVectorCb := v.MakeVector(v.RowSpace, gcv.MakeValue(0), gcv.MakeValue(2))
+	testMatrixC := m.MakeMatrix(testVectorCa, testVectorCb)
+
+	if _, err := MultBlocked(testMatrixC, testMatrixC, DefaultMultConfig); err == nil {
+		t.Fail()
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Printf("Recovered from %v error\n", r)
+		}
+	}()
+
+	result := MustMultBlocked(testMatrixC, testMatrixC, DefaultMultConfig)
+
+	if result != nil {
+		t.Error("Expected Panic")
+	}
+}
+
+func TestMultSimpleUsesBlocked(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	matrixA := randomMatrix(r, 12, 12, true)
+	matrixB := randomMatrix(r, 12, 12, true)
+	solution := simpleProduct(matrixA, matrixB)
+
+	config := MultConfig{BlockSize: 5, Workers: 4, Threshold: 10, StrassenThreshold: 4}
+
+	result, err := multSimple(matrixA, matrixB, config)
+	if err != nil || !matricesClose(result, solution, 1e-9) {
+		t.Error("Failure: MultSimple")
+	}
+
+	matrixC := randomMatrix(r, 12, 12, false)
+	powResult, err := pow(matrixC, 3, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	powSolution := simpleProduct(simpleProduct(matrixC, matrixC), matrixC)
+	if !matricesClose(powResult, powSolution, 1e-6) {
+		t.Error("Failure: Pow")
+	}
+}
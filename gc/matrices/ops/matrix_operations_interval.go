@@ -0,0 +1,77 @@
+package mops
+
+import (
+	"errors"
+	"math"
+
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	gcv "github.com/NumberXNumbers/types/gc/values"
+)
+
+// MakeIntervalMatrix returns a Matrix of Interval Values from the Real Matrices lower and upper.
+// Returns error if lower and upper do not match in size or are not Real
+func MakeIntervalMatrix(lower m.Matrix, upper m.Matrix) (m.Matrix, error) {
+	rows, cols := lower.Dim()
+	if upperRows, upperCols := upper.Dim(); rows != upperRows || cols != upperCols {
+		return nil, errors.New("Matrices are not of the same size")
+	}
+	if lower.Type() != gcv.Real || upper.Type() != gcv.Real {
+		return nil, errors.New("Matrices are not Real")
+	}
+
+	matrix := m.NewMatrix(rows, cols)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			matrix.Set(i, j, gcv.MakeInterval(lower.Get(i, j).Real(), upper.Get(i, j).Real()))
+		}
+	}
+	return matrix, nil
+}
+
+// MustMakeIntervalMatrix is the same as MakeIntervalMatrix but will panic
+func MustMakeIntervalMatrix(lower m.Matrix, upper m.Matrix) m.Matrix {
+	matrix, err := MakeIntervalMatrix(lower, upper)
+	if err != nil {
+		panic(err)
+	}
+	return matrix
+}
+
+// IntervalBounds returns the Real Matrices of the lower and upper bounds of each element of matrix
+func IntervalBounds(matrix m.Matrix) (lower m.Matrix, upper m.Matrix) {
+	rows, cols := matrix.Dim()
+	lower, upper = m.NewMatrix(rows, cols), m.NewMatrix(rows, cols)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			lowerBound, upperBound := gcv.IntervalBounds(matrix.Get(i, j))
+			lower.Set(i, j, gcv.MakeValue(lowerBound))
+			upper.Set(i, j, gcv.MakeValue(upperBound))
+		}
+	}
+	return lower, upper
+}
+
+// IntervalMidpoint returns the Real Matrix of the midpoints of each element of matrix
+func IntervalMidpoint(matrix m.Matrix) m.Matrix {
+	rows, cols := matrix.Dim()
+	midpoint := m.NewMatrix(rows, cols)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			midpoint.Set(i, j, gcv.MakeValue(matrix.Get(i, j).Real()))
+		}
+	}
+	return midpoint
+}
+
+// IntervalWidth returns the largest width of the elements of matrix
+func IntervalWidth(matrix m.Matrix) float64 {
+	rows, cols := matrix.Dim()
+	width := 0.0
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			lower, upper := gcv.IntervalBounds(matrix.Get(i, j))
+			width = math.Max(width, upper-lower)
+		}
+	}
+	return width
+}
@@ -0,0 +1,65 @@
+package mops
+
+import (
+	"testing"
+
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+func TestIntervalMatrix(t *testing.T) {
+	lower := m.MakeMatrix(v.MakeVector(v.RowSpace, 1, 0), v.MakeVector(v.RowSpace, 0, 1))
+	upper := m.MakeMatrix(v.MakeVector(v.RowSpace, 1, 0.5), v.MakeVector(v.RowSpace, 0, 2))
+	matrix := MustMakeIntervalMatrix(lower, upper)
+	if matrix.Type() != gcv.Interval {
+		t.Errorf("Expected %v, received %v", gcv.Interval, matrix.Type())
+	}
+
+	if width := IntervalWidth(matrix); width != 1 {
+		t.Errorf("Expected %v, received %v", 1, width)
+	}
+
+	midpoint := IntervalMidpoint(matrix)
+	if midpoint.Type() != gcv.Real || midpoint.Get(0, 1).Real() != 0.25 || midpoint.Get(1, 1).Real() != 1.5 {
+		t.Errorf("Expected %v, received %v", []float64{1, 0.25, 0, 1.5}, midpoint.Elements())
+	}
+
+	lowerBounds, upperBounds := IntervalBounds(matrix)
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			if lowerBounds.Get(i, j).Real() != lower.Get(i, j).Real() || upperBounds.Get(i, j).Real() != upper.Get(i, j).Real() {
+				t.Errorf("Expected %v, received %v", matrix.Get(i, j), []gcv.Value{lowerBounds.Get(i, j), upperBounds.Get(i, j)})
+			}
+		}
+	}
+
+	// the product encloses the product of every pair of point matrices inside the intervals
+	product := MustMultSimple(matrix, matrix)
+	if product.Type() != gcv.Interval {
+		t.Errorf("Expected %v, received %v", gcv.Interval, product.Type())
+	}
+	corner := MustMultSimple(upper, upper)
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			if !product.Get(i, j).(gcv.IntervalValue).Contains(corner.Get(i, j)) {
+				t.Errorf("Expected %v to contain %v", product.Get(i, j), corner.Get(i, j))
+			}
+		}
+	}
+
+	if _, err := MakeIntervalMatrix(lower, m.NewMatrix(2, 3)); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := MakeIntervalMatrix(lower, m.MakeMatrix(v.MakeVector(v.RowSpace, 1i, 0), v.MakeVector(v.RowSpace, 0, 1))); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic")
+		}
+	}()
+	MustMakeIntervalMatrix(lower, m.NewMatrix(3, 2))
+}
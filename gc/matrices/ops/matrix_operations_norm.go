@@ -0,0 +1,197 @@
+package mops
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"sort"
+
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+	vops "github.com/NumberXNumbers/types/gc/vectors/ops"
+)
+
+// maxJacobiSweeps is the most sweeps SingularValues makes before giving up
+const maxJacobiSweeps = 60
+
+// FrobeniusNorm returns the Frobenius norm of matrix, the square root of the sum of the squared
+// absolute values of its elements
+func FrobeniusNorm(matrix m.Matrix) gcv.Value {
+	rows, cols := matrix.Dim()
+	elements := v.NewVector(v.ColSpace, rows*cols)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			elements.Set(i*cols+j, matrix.Get(i, j))
+		}
+	}
+	return vops.MustPNorm(elements, 2)
+}
+
+// OneNorm returns the 1-norm of matrix, the largest absolute column sum
+func OneNorm(matrix m.Matrix) gcv.Value {
+	norm := 0.0
+	for j := 0; j < matrix.GetNumCols(); j++ {
+		column := v.NewVector(v.ColSpace, matrix.GetNumRows())
+		for i := 0; i < matrix.GetNumRows(); i++ {
+			column.Set(i, matrix.Get(i, j))
+		}
+		norm = math.Max(norm, vops.MustPNorm(column, 1).Real())
+	}
+	return gcv.MakeValue(norm)
+}
+
+// InfNorm returns the infinity norm of matrix, the largest absolute row sum
+func InfNorm(matrix m.Matrix) gcv.Value {
+	norm := 0.0
+	for i := 0; i < matrix.GetNumRows(); i++ {
+		norm = math.Max(norm, vops.MustPNorm(matrix.Elements().Get(i), 1).Real())
+	}
+	return gcv.MakeValue(norm)
+}
+
+// SingularValues returns the singular values of a Real or Complex matrix in decreasing order,
+// using one-sided Jacobi rotations. Returns error for any other type of Matrix
+func SingularValues(matrix m.Matrix) (v.Vector, error) {
+	if matrix.Type() != gcv.Real && matrix.Type() != gcv.Complex {
+		return nil, errors.New("Singular values are only supported for Real and Complex matrices")
+	}
+
+	// work on the columns of the taller of the matrix and its conjugate transpose,
+	// scaled by the largest element so the column norms can not overflow
+	rows, cols := matrix.Dim()
+	get := func(i, j int) complex128 { return matrix.Get(i, j).Complex() }
+	if rows < cols {
+		rows, cols = cols, rows
+		get = func(i, j int) complex128 { return cmplx.Conj(matrix.Get(j, i).Complex()) }
+	}
+	scale := 0.0
+	columns := make([][]complex128, cols)
+	for j := range columns {
+		columns[j] = make([]complex128, rows)
+		for i := range columns[j] {
+			columns[j][i] = get(i, j)
+			scale = math.Max(scale, cmplx.Abs(columns[j][i]))
+		}
+	}
+	if scale == 0 || math.IsInf(scale, 1) {
+		values := v.NewVector(v.ColSpace, cols)
+		for j := 0; j < cols; j++ {
+			values.Set(j, gcv.MakeValue(scale))
+		}
+		return values, nil
+	}
+	for _, column := range columns {
+		for i := range column {
+			column[i] /= complex(scale, 0)
+		}
+	}
+
+	converged := false
+	for sweep := 0; sweep < maxJacobiSweeps && !converged; sweep++ {
+		converged = true
+		for p := 0; p < cols-1; p++ {
+			for q := p + 1; q < cols; q++ {
+				if !rotateColumns(columns[p], columns[q]) {
+					converged = false
+				}
+			}
+		}
+	}
+	if !converged {
+		return nil, errors.New("Singular values did not converge")
+	}
+
+	singularValues := make([]float64, cols)
+	for j, column := range columns {
+		sum := 0.0
+		for _, element := range column {
+			sum += real(element)*real(element) + imag(element)*imag(element)
+		}
+		singularValues[j] = scale * math.Sqrt(sum)
+	}
+	sort.Sort(sort.Reverse(sort.Float64Slice(singularValues)))
+
+	values := v.NewVector(v.ColSpace, cols)
+	for j, singularValue := range singularValues {
+		values.Set(j, gcv.MakeValue(singularValue))
+	}
+	return values, nil
+}
+
+// MustSingularValues is the same as SingularValues but will panic
+func MustSingularValues(matrix m.Matrix) v.Vector {
+	values, err := SingularValues(matrix)
+	if err != nil {
+		panic(err)
+	}
+	return values
+}
+
+// rotateColumns makes columns a and b orthogonal with a Jacobi rotation, after turning b by a
+// phase so their inner product is real. Returns true if they were already orthogonal
+func rotateColumns(a []complex128, b []complex128) bool {
+	var alpha, beta float64
+	var gamma complex128
+	for i := range a {
+		alpha += real(a[i])*real(a[i]) + imag(a[i])*imag(a[i])
+		beta += real(b[i])*real(b[i]) + imag(b[i])*imag(b[i])
+		gamma += cmplx.Conj(a[i]) * b[i]
+	}
+	size := cmplx.Abs(gamma)
+	if size <= 1e-14*math.Sqrt(alpha*beta) {
+		return true
+	}
+
+	phase := cmplx.Conj(gamma) / complex(size, 0)
+	zeta := (beta - alpha) / (2 * size)
+	t := math.Copysign(1, zeta) / (math.Abs(zeta) + math.Sqrt(1+zeta*zeta))
+	c := 1 / math.Sqrt(1+t*t)
+	s := c * t
+	for i := range a {
+		bi := b[i] * phase
+		a[i], b[i] = complex(c, 0)*a[i]-complex(s, 0)*bi, complex(s, 0)*a[i]+complex(c, 0)*bi
+	}
+	return false
+}
+
+// SpectralNorm returns the 2-norm of a Real or Complex matrix, its largest singular value.
+// Returns error for any other type of Matrix
+func SpectralNorm(matrix m.Matrix) (gcv.Value, error) {
+	values, err := SingularValues(matrix)
+	if err != nil {
+		return nil, err
+	}
+	if values.Len() == 0 {
+		return gcv.Zero(), nil
+	}
+	return values.Get(0), nil
+}
+
+// MustSpectralNorm is the same as SpectralNorm but will panic
+func MustSpectralNorm(matrix m.Matrix) gcv.Value {
+	norm, err := SpectralNorm(matrix)
+	if err != nil {
+		panic(err)
+	}
+	return norm
+}
+
+// NuclearNorm returns the nuclear norm of a Real or Complex matrix, the sum of its singular values.
+// Returns error for any other type of Matrix
+func NuclearNorm(matrix m.Matrix) (gcv.Value, error) {
+	values, err := SingularValues(matrix)
+	if err != nil {
+		return nil, err
+	}
+	return vops.MustPNorm(values, 1), nil
+}
+
+// MustNuclearNorm is the same as NuclearNorm but will panic
+func MustNuclearNorm(matrix m.Matrix) gcv.Value {
+	norm, err := NuclearNorm(matrix)
+	if err != nil {
+		panic(err)
+	}
+	return norm
+}
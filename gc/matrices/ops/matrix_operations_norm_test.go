@@ -0,0 +1,95 @@
+package mops
+
+import (
+	"math"
+	"testing"
+
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+func TestMatrixNorms(t *testing.T) {
+	matrix := m.MakeMatrix(v.MakeVector(v.RowSpace, 1, -2), v.MakeVector(v.RowSpace, 3, 4))
+
+	if norm := FrobeniusNorm(matrix); math.Abs(norm.Real()-math.Sqrt(30)) > 1e-12 {
+		t.Errorf("Expected %v, received %v", math.Sqrt(30), norm)
+	}
+
+	if norm := OneNorm(matrix); norm.Real() != 6 {
+		t.Errorf("Expected %v, received %v", 6, norm)
+	}
+
+	if norm := InfNorm(matrix); norm.Real() != 7 {
+		t.Errorf("Expected %v, received %v", 7, norm)
+	}
+
+	// the singular values of [[1, -2], [3, 4]] are sqrt(15 +- sqrt(125))
+	largest, smallest := math.Sqrt(15+math.Sqrt(125)), math.Sqrt(15-math.Sqrt(125))
+	if norm := MustSpectralNorm(matrix); math.Abs(norm.Real()-largest) > 1e-12 {
+		t.Errorf("Expected %v, received %v", largest, norm)
+	}
+
+	if norm := MustNuclearNorm(matrix); math.Abs(norm.Real()-largest-smallest) > 1e-12 {
+		t.Errorf("Expected %v, received %v", largest+smallest, norm)
+	}
+
+	huge := SMult(gcv.MakeValue(1e300), matrix)
+	if norm := FrobeniusNorm(huge); math.Abs(norm.Real()/(1e300*math.Sqrt(30))-1) > 1e-12 {
+		t.Errorf("Expected %v, received %v", 1e300*math.Sqrt(30), norm)
+	}
+
+	if norm := MustSpectralNorm(huge); math.Abs(norm.Real()/(1e300*largest)-1) > 1e-12 {
+		t.Errorf("Expected %v, received %v", 1e300*largest, norm)
+	}
+}
+
+func TestSingularValues(t *testing.T) {
+	// a wide matrix with orthogonal rows of lengths 3 and 2
+	wide := m.MakeMatrix(v.MakeVector(v.RowSpace, 0, 3, 0), v.MakeVector(v.RowSpace, 2, 0, 0))
+	values := MustSingularValues(wide)
+	if values.Len() != 2 || math.Abs(values.Get(0).Real()-3) > 1e-12 || math.Abs(values.Get(1).Real()-2) > 1e-12 {
+		t.Errorf("Expected %v, received %v", []float64{3, 2}, values.Elements())
+	}
+
+	// a complex unitary matrix has all singular values 1
+	unitary := m.MakeMatrix(v.MakeVector(v.RowSpace, 1/math.Sqrt2, 1i/math.Sqrt2), v.MakeVector(v.RowSpace, 1i/math.Sqrt2, 1/math.Sqrt2))
+	values = MustSingularValues(unitary)
+	for i := 0; i < values.Len(); i++ {
+		if math.Abs(values.Get(i).Real()-1) > 1e-12 {
+			t.Errorf("Expected %v, received %v", 1, values.Get(i))
+		}
+	}
+
+	// a rank one matrix
+	rankOne := m.MakeMatrix(v.MakeVector(v.RowSpace, 1, 2, 3), v.MakeVector(v.RowSpace, 2, 4, 6), v.MakeVector(v.RowSpace, 3, 6, 9))
+	values = MustSingularValues(rankOne)
+	if math.Abs(values.Get(0).Real()-14) > 1e-12 || math.Abs(values.Get(1).Real()) > 1e-12 || math.Abs(values.Get(2).Real()) > 1e-12 {
+		t.Errorf("Expected %v, received %v", []float64{14, 0, 0}, values.Elements())
+	}
+
+	if norm := MustSpectralNorm(m.NewMatrix(2, 2)); !norm.IsZero() {
+		t.Errorf("Expected %v, received %v", 0, norm)
+	}
+
+	quaternion := m.NewMatrix(1, 1)
+	quaternion.Set(0, 0, gcv.MakeQuaternion(1, 0, 1, 0))
+	if _, err := SpectralNorm(quaternion); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := NuclearNorm(quaternion); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if norm := FrobeniusNorm(quaternion); math.Abs(norm.Real()-math.Sqrt2) > 1e-12 {
+		t.Errorf("Expected %v, received %v", math.Sqrt2, norm)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic")
+		}
+	}()
+	MustSingularValues(quaternion)
+}
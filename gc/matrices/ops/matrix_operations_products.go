@@ -0,0 +1,105 @@
+package mops
+
+import (
+	"errors"
+
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	gcvops "github.com/NumberXNumbers/types/gc/values/ops"
+)
+
+// Kron returns the Kronecker (tensor) product of two Matrices. For an (m X n) matrixA and a
+// (p X q) matrixB the result is (mp X nq), with block (i, j) equal to a_ij * matrixB
+func Kron(matrixA m.Matrix, matrixB m.Matrix) m.Matrix {
+	rowsA, colsA := matrixA.Dim()
+	rowsB, colsB := matrixB.Dim()
+	matrixAB := m.NewMatrix(rowsA*rowsB, colsA*colsB)
+	for i := 0; i < rowsA; i++ {
+		for j := 0; j < colsA; j++ {
+			valueA := matrixA.Get(i, j)
+			if valueA.IsZero() {
+				continue
+			}
+			for k := 0; k < rowsB; k++ {
+				for l := 0; l < colsB; l++ {
+					matrixAB.Set(i*rowsB+k, j*colsB+l, gcvops.Mult(valueA, matrixB.Get(k, l)))
+				}
+			}
+		}
+	}
+	return matrixAB
+}
+
+// Hadamard returns the element wise product of two Matrices of the same size
+func Hadamard(matrixA m.Matrix, matrixB m.Matrix) (m.Matrix, error) {
+	if matrixA.GetNumCols() != matrixB.GetNumCols() || matrixA.GetNumRows() != matrixB.GetNumRows() {
+		return nil, errors.New("Matrices do not have equivalent dimensions")
+	}
+
+	matrixAB := m.NewMatrix(matrixA.GetNumRows(), matrixA.GetNumCols())
+	for i := 0; i < matrixA.GetNumRows(); i++ {
+		for j := 0; j < matrixA.GetNumCols(); j++ {
+			matrixAB.Set(i, j, gcvops.Mult(matrixA.Get(i, j), matrixB.Get(i, j)))
+		}
+	}
+	return matrixAB, nil
+}
+
+// MustHadamard is the same as Hadamard, but will panic
+func MustHadamard(matrixA m.Matrix, matrixB m.Matrix) m.Matrix {
+	matrixAB, err := Hadamard(matrixA, matrixB)
+	if err != nil {
+		panic(err)
+	}
+	return matrixAB
+}
+
+// HadamardDiv returns the element wise quotient of two Matrices of the same size.
+// Returns error if an element of matrixB is zero
+func HadamardDiv(matrixA m.Matrix, matrixB m.Matrix) (m.Matrix, error) {
+	if matrixA.GetNumCols() != matrixB.GetNumCols() || matrixA.GetNumRows() != matrixB.GetNumRows() {
+		return nil, errors.New("Matrices do not have equivalent dimensions")
+	}
+
+	matrixAB := m.NewMatrix(matrixA.GetNumRows(), matrixA.GetNumCols())
+	for i := 0; i < matrixA.GetNumRows(); i++ {
+		for j := 0; j < matrixA.GetNumCols(); j++ {
+			if matrixB.Get(i, j).IsZero() {
+				return nil, errors.New("Matrix B has a zero element")
+			}
+			matrixAB.Set(i, j, gcvops.Div(matrixA.Get(i, j), matrixB.Get(i, j)))
+		}
+	}
+	return matrixAB, nil
+}
+
+// MustHadamardDiv is the same as HadamardDiv, but will panic
+func MustHadamardDiv(matrixA m.Matrix, matrixB m.Matrix) m.Matrix {
+	matrixAB, err := HadamardDiv(matrixA, matrixB)
+	if err != nil {
+		panic(err)
+	}
+	return matrixAB
+}
+
+// DirectSum returns the block diagonal Matrix with matrices along its diagonal, in order,
+// and zeros everywhere else
+func DirectSum(matrices ...m.Matrix) m.Matrix {
+	rows, cols := 0, 0
+	for _, matrix := range matrices {
+		rows += matrix.GetNumRows()
+		cols += matrix.GetNumCols()
+	}
+
+	sum := m.NewMatrix(rows, cols)
+	rowOffset, colOffset := 0, 0
+	for _, matrix := range matrices {
+		for i := 0; i < matrix.GetNumRows(); i++ {
+			for j := 0; j < matrix.GetNumCols(); j++ {
+				sum.Set(rowOffset+i, colOffset+j, matrix.Get(i, j))
+			}
+		}
+		rowOffset += matrix.GetNumRows()
+		colOffset += matrix.GetNumCols()
+	}
+	return sum
+}
@@ -0,0 +1,85 @@
+package mops
+
+import (
+	"reflect"
+	"testing"
+
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+func TestKron(t *testing.T) {
+	pauliX := m.MakeMatrix(v.MakeVector(v.RowSpace, 0, 1), v.MakeVector(v.RowSpace, 1, 0))
+	pauliY := m.MakeMatrix(v.MakeVector(v.RowSpace, 0, -1i), v.MakeVector(v.RowSpace, 1i, 0))
+
+	result := Kron(pauliX, pauliY)
+	solution := m.MakeMatrix(
+		v.MakeVector(v.RowSpace, 0, 0, 0, -1i),
+		v.MakeVector(v.RowSpace, 0, 0, 1i, 0),
+		v.MakeVector(v.RowSpace, 0, -1i, 0, 0),
+		v.MakeVector(v.RowSpace, 1i, 0, 0, 0))
+	if !reflect.DeepEqual(result, solution) {
+		t.Errorf("Expected %v, received %v", solution, result)
+	}
+
+	column := m.MakeMatrix(v.MakeVector(v.RowSpace, 1), v.MakeVector(v.RowSpace, 2))
+	row := m.MakeMatrix(v.MakeVector(v.RowSpace, 3, 4, 5))
+	result = Kron(column, row)
+	if rows, cols := result.Dim(); rows != 2 || cols != 3 || result.Get(1, 2).Real() != 10 {
+		t.Errorf("Expected %v, received %v", "[[3 4 5] [6 8 10]]", result)
+	}
+}
+
+func TestHadamard(t *testing.T) {
+	matrixA := m.MakeMatrix(v.MakeVector(v.RowSpace, 1, 2), v.MakeVector(v.RowSpace, 3, 1i))
+	matrixB := m.MakeMatrix(v.MakeVector(v.RowSpace, 2, 2), v.MakeVector(v.RowSpace, -1, 1i))
+
+	result := MustHadamard(matrixA, matrixB)
+	solution := m.MakeMatrix(v.MakeVector(v.RowSpace, 2, 4), v.MakeVector(v.RowSpace, -3, -1))
+	if !reflect.DeepEqual(result, solution) {
+		t.Errorf("Expected %v, received %v", solution, result)
+	}
+
+	result = MustHadamardDiv(matrixA, matrixB)
+	solution = m.MakeMatrix(v.MakeVector(v.RowSpace, 0.5, 1), v.MakeVector(v.RowSpace, -3, 1))
+	if !reflect.DeepEqual(result, solution) {
+		t.Errorf("Expected %v, received %v", solution, result)
+	}
+
+	if _, err := HadamardDiv(matrixA, m.NewMatrix(2, 2)); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := Hadamard(matrixA, m.NewMatrix(2, 3)); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := HadamardDiv(matrixA, m.NewMatrix(3, 2)); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic")
+		}
+	}()
+	MustHadamard(matrixA, m.NewMatrix(1, 2))
+}
+
+func TestDirectSum(t *testing.T) {
+	matrixA := m.MakeMatrix(v.MakeVector(v.RowSpace, 1, 2))
+	matrixB := m.MakeMatrix(v.MakeVector(v.RowSpace, 3), v.MakeVector(v.RowSpace, 1i))
+
+	result := DirectSum(matrixA, matrixB)
+	solution := m.MakeMatrix(
+		v.MakeVector(v.RowSpace, 1, 2, 0),
+		v.MakeVector(v.RowSpace, 0, 0, 3),
+		v.MakeVector(v.RowSpace, 0, 0, 1i))
+	if !reflect.DeepEqual(result, solution) {
+		t.Errorf("Expected %v, received %v", solution, result)
+	}
+
+	if rows, cols := DirectSum().Dim(); rows != 0 || cols != 0 {
+		t.Errorf("Expected %v, received %v", []int{0, 0}, []int{rows, cols})
+	}
+}
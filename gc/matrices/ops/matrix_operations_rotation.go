@@ -0,0 +1,123 @@
+package mops
+
+import (
+	"errors"
+	"math"
+
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	gcv "github.com/NumberXNumbers/types/gc/values"
+)
+
+// rotationTolerance is how far R*R^T may be from the identity matrix for R to be a rotation
+const rotationTolerance = 1e-9
+
+// QuaternionToRotation returns the 3x3 rotation Matrix of gcv Value value taken as a quaternion.
+// The quaternion is normalized first. Returns error if value is zero
+func QuaternionToRotation(value gcv.Value) (m.Matrix, error) {
+	w, x, y, z := gcv.QuaternionParts(value)
+	norm := math.Hypot(math.Hypot(w, x), math.Hypot(y, z))
+	if norm == 0 {
+		return nil, errors.New("Zero Value does not represent a rotation")
+	}
+	w, x, y, z = w/norm, x/norm, y/norm, z/norm
+
+	elements := [3][3]float64{
+		{1 - 2*(y*y+z*z), 2 * (x*y - z*w), 2 * (x*z + y*w)},
+		{2 * (x*y + z*w), 1 - 2*(x*x+z*z), 2 * (y*z - x*w)},
+		{2 * (x*z - y*w), 2 * (y*z + x*w), 1 - 2*(x*x+y*y)},
+	}
+	matrix := m.NewMatrix(3, 3)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			matrix.Set(i, j, gcv.MakeValue(elements[i][j]))
+		}
+	}
+	return matrix, nil
+}
+
+// MustQuaternionToRotation is the same as QuaternionToRotation, but will panic
+func MustQuaternionToRotation(value gcv.Value) m.Matrix {
+	matrix, err := QuaternionToRotation(value)
+	if err != nil {
+		panic(err)
+	}
+	return matrix
+}
+
+// RotationToQuaternion returns the unit quaternion, with a non negative real part,
+// of a 3x3 rotation Matrix. Returns error if matrix is not a Real rotation Matrix
+func RotationToQuaternion(matrix m.Matrix) (gcv.Value, error) {
+	if matrix.GetNumRows() != 3 || matrix.GetNumCols() != 3 {
+		return nil, errors.New("Matrix is not of size 3x3")
+	}
+	if matrix.Type() != gcv.Real {
+		return nil, errors.New("Matrix is not Real")
+	}
+
+	var r [3][3]float64
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			r[i][j] = matrix.Get(i, j).Real()
+		}
+	}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			dot := r[i][0]*r[j][0] + r[i][1]*r[j][1] + r[i][2]*r[j][2]
+			if i == j {
+				dot--
+			}
+			if math.Abs(dot) > rotationTolerance {
+				return nil, errors.New("Matrix is not orthogonal")
+			}
+		}
+	}
+	det := r[0][0]*(r[1][1]*r[2][2]-r[1][2]*r[2][1]) -
+		r[0][1]*(r[1][0]*r[2][2]-r[1][2]*r[2][0]) +
+		r[0][2]*(r[1][0]*r[2][1]-r[1][1]*r[2][0])
+	if det < 0 {
+		return nil, errors.New("Matrix is a reflection, not a rotation")
+	}
+
+	// branch on the largest of w, x, y and z to keep the division well conditioned
+	var w, x, y, z float64
+	trace := r[0][0] + r[1][1] + r[2][2]
+	switch {
+	case trace > 0:
+		s := 2 * math.Sqrt(1+trace)
+		w = s / 4
+		x = (r[2][1] - r[1][2]) / s
+		y = (r[0][2] - r[2][0]) / s
+		z = (r[1][0] - r[0][1]) / s
+	case r[0][0] > r[1][1] && r[0][0] > r[2][2]:
+		s := 2 * math.Sqrt(1+r[0][0]-r[1][1]-r[2][2])
+		w = (r[2][1] - r[1][2]) / s
+		x = s / 4
+		y = (r[0][1] + r[1][0]) / s
+		z = (r[0][2] + r[2][0]) / s
+	case r[1][1] > r[2][2]:
+		s := 2 * math.Sqrt(1+r[1][1]-r[0][0]-r[2][2])
+		w = (r[0][2] - r[2][0]) / s
+		x = (r[0][1] + r[1][0]) / s
+		y = s / 4
+		z = (r[1][2] + r[2][1]) / s
+	default:
+		s := 2 * math.Sqrt(1+r[2][2]-r[0][0]-r[1][1])
+		w = (r[1][0] - r[0][1]) / s
+		x = (r[0][2] + r[2][0]) / s
+		y = (r[1][2] + r[2][1]) / s
+		z = s / 4
+	}
+	if w < 0 {
+		w, x, y, z = -w, -x, -y, -z
+	}
+	return gcv.MakeQuaternion(w, x, y, z), nil
+}
+
+// MustRotationToQuaternion is the same as RotationToQuaternion, but will panic
+func MustRotationToQuaternion(matrix m.Matrix) gcv.Value {
+	value, err := RotationToQuaternion(matrix)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
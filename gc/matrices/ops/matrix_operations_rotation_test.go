@@ -0,0 +1,134 @@
+package mops
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	gcvops "github.com/NumberXNumbers/types/gc/values/ops"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+func rotationsClose(matrixA m.Matrix, matrixB m.Matrix) bool {
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			if math.Abs(matrixA.Get(i, j).Real()-matrixB.Get(i, j).Real()) > 1e-12 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestQuaternionToRotation(t *testing.T) {
+	quarterTurn := gcv.MakeQuaternion(math.Sqrt2/2, 0, 0, math.Sqrt2/2)
+	rotation, err := QuaternionToRotation(quarterTurn)
+	solution := m.MakeMatrix(
+		v.MakeVector(v.RowSpace, gcv.MakeValue(0), gcv.MakeValue(-1), gcv.MakeValue(0)),
+		v.MakeVector(v.RowSpace, gcv.MakeValue(1), gcv.MakeValue(0), gcv.MakeValue(0)),
+		v.MakeVector(v.RowSpace, gcv.MakeValue(0), gcv.MakeValue(0), gcv.MakeValue(1)))
+	if err != nil || !rotationsClose(rotation, solution) {
+		t.Errorf("Expected %v, received %v", solution, rotation)
+	}
+
+	// R*p is the same as the vector part of q*p*q^-1
+	quaternion := gcv.MakeQuaternion(1, 2, 3, 4)
+	rotation = MustQuaternionToRotation(quaternion)
+	rotated := MustMultSimple(rotation, m.MakeMatrix(
+		v.MakeVector(v.RowSpace, gcv.MakeValue(1)),
+		v.MakeVector(v.RowSpace, gcv.MakeValue(-1)),
+		v.MakeVector(v.RowSpace, gcv.MakeValue(2))))
+	w, x, y, z := gcv.QuaternionParts(gcvops.Mult(gcvops.Mult(quaternion, gcv.MakeQuaternion(0, 1, -1, 2)), gcvops.Inv(quaternion)))
+	if math.Abs(w) > 1e-12 || math.Abs(rotated.Get(0, 0).Real()-x) > 1e-12 ||
+		math.Abs(rotated.Get(1, 0).Real()-y) > 1e-12 || math.Abs(rotated.Get(2, 0).Real()-z) > 1e-12 {
+		t.Errorf("Expected %v, received %v", []float64{x, y, z}, rotated)
+	}
+
+	if _, err = QuaternionToRotation(gcv.Zero()); err == nil {
+		t.Fail()
+	}
+}
+
+func TestMustQuaternionToRotation(t *testing.T) {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Printf("Recovered from %v error\n", r)
+		}
+	}()
+
+	MustQuaternionToRotation(gcv.Zero())
+	t.Errorf("Expected panic from MustQuaternionToRotation")
+}
+
+func TestRotationToQuaternion(t *testing.T) {
+	for _, quaternion := range []gcv.Value{
+		gcv.MakeValue(1),
+		gcv.MakeQuaternion(1, 2, 3, 4),
+		gcv.MakeQuaternion(0, 1, 0, 0),
+		gcv.MakeQuaternion(0.1, 0, 1, 0),
+		gcv.MakeQuaternion(0.1, 0.2, 0, 1),
+		gcv.MakeQuaternion(-1, 2, -3, 4),
+	} {
+		result, err := RotationToQuaternion(MustQuaternionToRotation(quaternion))
+		w, _, _, _ := gcv.QuaternionParts(quaternion)
+		solution := gcvops.Div(quaternion, gcvops.Abs(quaternion))
+		if w < 0 {
+			solution = gcvops.Mult(gcv.MakeValue(-1), solution)
+		}
+		if err != nil || gcvops.Abs(gcvops.Sub(result, solution)).Real() > 1e-12 {
+			t.Errorf("Expected %v, received %v", solution, result)
+		}
+	}
+
+	reflection := m.NewIdentityMatrix(3)
+	reflection.Set(2, 2, gcv.MakeValue(-1))
+	if _, err := RotationToQuaternion(reflection); err == nil {
+		t.Error("Expected error for reflection")
+	}
+
+	scaled := m.NewIdentityMatrix(3)
+	scaled.Set(0, 0, gcv.MakeValue(2))
+	if _, err := RotationToQuaternion(scaled); err == nil {
+		t.Error("Expected error for non orthogonal matrix")
+	}
+
+	complexMatrix := m.NewIdentityMatrix(3)
+	complexMatrix.Set(0, 0, gcv.MakeValue(1i))
+	if _, err := RotationToQuaternion(complexMatrix); err == nil {
+		t.Error("Expected error for complex matrix")
+	}
+
+	if _, err := RotationToQuaternion(m.NewIdentityMatrix(2)); err == nil {
+		t.Error("Expected error for 2x2 matrix")
+	}
+}
+
+func TestMustRotationToQuaternion(t *testing.T) {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Printf("Recovered from %v error\n", r)
+		}
+	}()
+
+	MustRotationToQuaternion(m.NewIdentityMatrix(2))
+	t.Errorf("Expected panic from MustRotationToQuaternion")
+}
+
+func TestQuaternionMatrixMult(t *testing.T) {
+	i := gcv.MakeValue(1i)
+	j := gcv.MakeQuaternion(0, 0, 1, 0)
+	matrixA := m.MakeMatrix(v.MakeVector(v.RowSpace, i))
+	matrixB := m.MakeMatrix(v.MakeVector(v.RowSpace, j))
+
+	if matrixB.Type() != gcv.Quaternion {
+		t.Errorf("Expected %v, received %v", gcv.Quaternion, matrixB.Type())
+	}
+
+	resultAB := MustMultSimple(matrixA, matrixB).Get(0, 0)
+	resultBA := MustMultSimple(matrixB, matrixA).Get(0, 0)
+	if resultAB.String() != "(0+0i+0j+1k)" || resultBA.String() != "(0+0i+0j-1k)" {
+		t.Errorf("Expected %v and %v, received %v and %v", "(0+0i+0j+1k)", "(0+0i+0j-1k)", resultAB, resultBA)
+	}
+}
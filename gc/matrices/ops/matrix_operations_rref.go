@@ -0,0 +1,90 @@
+package mops
+
+import (
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	gcvops "github.com/NumberXNumbers/types/gc/values/ops"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+// The functions in this file take the tolerance of m.RREFTol. Use m.DefaultTolerance to have it
+// chosen from the matrix, or 0 for the exact mode of m.RREFExact
+
+// makeVectors returns vectors in space, or no vectors of length length if there are none
+func makeVectors(space v.Space, length int, vectors []v.Vector) v.Vectors {
+	if len(vectors) == 0 {
+		return v.NewVectors(space, 0, length)
+	}
+	return v.MakeVectorsAlt(space, vectors)
+}
+
+// Rank returns the rank of matrix, the number of pivots of its reduced row echelon form
+func Rank(matrix m.Matrix, tolerance float64) int {
+	_, pivots := m.RREFTol(matrix, tolerance)
+	return len(pivots)
+}
+
+// PivotColumns returns the indices of the pivot columns of matrix in increasing order
+func PivotColumns(matrix m.Matrix, tolerance float64) []int {
+	_, pivots := m.RREFTol(matrix, tolerance)
+	return pivots
+}
+
+// NullSpace returns a basis of the null space of matrix, the ColSpace Vectors x with matrix x = 0.
+// There is one Vector for each column of matrix that is not a pivot column
+func NullSpace(matrix m.Matrix, tolerance float64) v.Vectors {
+	reduced, pivots := m.RREFTol(matrix, tolerance)
+	cols := reduced.GetNumCols()
+	isPivot := make([]bool, cols)
+	for _, pivot := range pivots {
+		isPivot[pivot] = true
+	}
+
+	var basis []v.Vector
+	for free := 0; free < cols; free++ {
+		if isPivot[free] {
+			continue
+		}
+		vector := v.NewVector(v.ColSpace, cols)
+		vector.Set(free, gcv.MakeValue(1))
+		for row, pivot := range pivots {
+			vector.Set(pivot, gcvops.Mult(gcv.MakeValue(-1), reduced.Get(row, free)))
+		}
+		basis = append(basis, vector)
+	}
+	return makeVectors(v.ColSpace, cols, basis)
+}
+
+// ColumnSpace returns a basis of the column space of matrix, its pivot columns as ColSpace Vectors
+func ColumnSpace(matrix m.Matrix, tolerance float64) v.Vectors {
+	var basis []v.Vector
+	for _, pivot := range PivotColumns(matrix, tolerance) {
+		vector := v.NewVector(v.ColSpace, matrix.GetNumRows())
+		for i := 0; i < matrix.GetNumRows(); i++ {
+			vector.Set(i, matrix.Get(i, pivot))
+		}
+		basis = append(basis, vector)
+	}
+	return makeVectors(v.ColSpace, matrix.GetNumRows(), basis)
+}
+
+// RowSpace returns a basis of the row space of matrix, the non zero rows of its reduced row
+// echelon form as RowSpace Vectors
+func RowSpace(matrix m.Matrix, tolerance float64) v.Vectors {
+	reduced, pivots := m.RREFTol(matrix, tolerance)
+	var basis []v.Vector
+	for row := range pivots {
+		basis = append(basis, reduced.Elements().Get(row).Copy())
+	}
+	return makeVectors(v.RowSpace, matrix.GetNumCols(), basis)
+}
+
+// LeftNullSpace returns a basis of the left null space of matrix, the RowSpace Vectors y with y matrix = 0
+func LeftNullSpace(matrix m.Matrix, tolerance float64) v.Vectors {
+	var basis []v.Vector
+	nullSpace := NullSpace(m.MakeTransMatrix(matrix), tolerance)
+	for i := 0; i < nullSpace.Len(); i++ {
+		basis = append(basis, v.MakeTransVector(nullSpace.Get(i)))
+	}
+	return makeVectors(v.RowSpace, matrix.GetNumRows(), basis)
+}
@@ -0,0 +1,99 @@
+package mops
+
+import (
+	"reflect"
+	"testing"
+
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+func makeRREFTestMatrix() m.Matrix {
+	return m.MakeMatrix(
+		v.MakeVector(v.RowSpace, 1, 2, 1, 4),
+		v.MakeVector(v.RowSpace, 2, 4, 0, 6),
+		v.MakeVector(v.RowSpace, 3, 6, 1, 10))
+}
+
+// isZeroProduct returns true if the sum over k of left(i, k) right(k, j) is zero for every i and j,
+// where left and right are read through their Get functions
+func isZeroProduct(rows, inner, cols int, left func(i, k int) complex128, right func(k, j int) complex128) bool {
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			var sum complex128
+			for k := 0; k < inner; k++ {
+				sum += left(i, k) * right(k, j)
+			}
+			if sum != 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestRankAndPivotColumns(t *testing.T) {
+	testMatrix := makeRREFTestMatrix()
+
+	if Rank(testMatrix, 0) != 2 {
+		t.Errorf("Expected %d, received %d", 2, Rank(testMatrix, 0))
+	}
+
+	if !reflect.DeepEqual(PivotColumns(testMatrix, m.DefaultTolerance), []int{0, 2}) {
+		t.Errorf("Expected %v, received %v", []int{0, 2}, PivotColumns(testMatrix, m.DefaultTolerance))
+	}
+
+	if Rank(m.NewMatrix(2, 3), 0) != 0 {
+		t.Errorf("Expected %d, received %d", 0, Rank(m.NewMatrix(2, 3), 0))
+	}
+
+	thirds := m.MakeMatrix(v.MakeVector(v.RowSpace, 1.0/3, 1), v.MakeVector(v.RowSpace, 1, 3))
+	if Rank(thirds, 0) != 1 || Rank(thirds, m.DefaultTolerance) != 1 {
+		t.Errorf("Expected %d, received %d and %d", 1, Rank(thirds, 0), Rank(thirds, m.DefaultTolerance))
+	}
+}
+
+func TestFundamentalSubspaces(t *testing.T) {
+	testMatrix := makeRREFTestMatrix()
+
+	nullSpace := NullSpace(testMatrix, 0)
+	if nullSpace.Len() != 2 {
+		t.Fatalf("Expected %d null space vectors, received %d", 2, nullSpace.Len())
+	}
+	for i := 0; i < nullSpace.Len(); i++ {
+		vector := nullSpace.Get(i)
+		if !isZeroProduct(3, 4, 1,
+			func(i, k int) complex128 { return testMatrix.Get(i, k).Complex() },
+			func(k, j int) complex128 { return vector.Get(k).Complex() }) {
+			t.Errorf("Expected %v in the null space", vector.Elements())
+		}
+	}
+	if nullSpace.Get(0).Get(0).Real() != -2 || nullSpace.Get(0).Get(1).Real() != 1 || nullSpace.Get(1).Get(3).Real() != 1 {
+		t.Errorf("Expected [-2 1 0 0] and [-3 0 -1 1], received %v and %v", nullSpace.Get(0).Elements(), nullSpace.Get(1).Elements())
+	}
+
+	columnSpace := ColumnSpace(testMatrix, 0)
+	if columnSpace.Len() != 2 || columnSpace.Get(1).Get(0).Real() != 1 || !columnSpace.Get(1).Get(1).IsZero() {
+		t.Errorf("Expected columns 0 and 2, received %v", columnSpace.Vectors())
+	}
+
+	rowSpace := RowSpace(testMatrix, 0)
+	if rowSpace.Len() != 2 || rowSpace.Get(0).Get(1).Real() != 2 || rowSpace.Get(0).Get(3).Real() != 3 {
+		t.Errorf("Expected the rows of the rref, received %v", rowSpace.Vectors())
+	}
+
+	leftNullSpace := LeftNullSpace(testMatrix, 0)
+	if leftNullSpace.Len() != 1 || leftNullSpace.Get(0).Space() != v.RowSpace {
+		t.Fatalf("Expected 1 RowSpace vector, received %v", leftNullSpace.Vectors())
+	}
+	vector := leftNullSpace.Get(0)
+	if !isZeroProduct(1, 3, 4,
+		func(i, k int) complex128 { return vector.Get(k).Complex() },
+		func(k, j int) complex128 { return testMatrix.Get(k, j).Complex() }) {
+		t.Errorf("Expected %v in the left null space", vector.Elements())
+	}
+
+	if NullSpace(m.NewIdentityMatrix(3), 0).Len() != 0 {
+		t.Errorf("Expected an empty null space, received %v", NullSpace(m.NewIdentityMatrix(3), 0).Vectors())
+	}
+}
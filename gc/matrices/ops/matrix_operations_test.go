@@ -424,3 +424,19 @@ func TestMustPow(t *testing.T) {
 		t.Error("Expected Panic")
 	}
 }
+
+func TestAddSubQuantity(t *testing.T) {
+	metres := m.MakeMatrix(v.MakeVector(v.RowSpace, gcv.MustMakeQuantity(1, "m"), gcv.MustMakeQuantity(2, "m")))
+	kilometres := m.MakeMatrix(v.MakeVector(v.RowSpace, gcv.MustMakeQuantity(1, "km"), gcv.MustMakeQuantity(2, "km")))
+	seconds := m.MakeMatrix(v.MakeVector(v.RowSpace, gcv.MustMakeQuantity(1, "s"), gcv.MustMakeQuantity(2, "s")))
+
+	if difference, err := Sub(kilometres, metres); err != nil || difference.Get(0, 0).Real() != 0.999 {
+		t.Errorf("Expected 0.999 km, received %v, %v", difference, err)
+	}
+	if _, err := Add(metres, seconds); err == nil {
+		t.Errorf("Expected error adding metres and seconds")
+	}
+	if _, err := Sub(metres, seconds); err == nil {
+		t.Errorf("Expected error subtracting seconds from metres")
+	}
+}
@@ -0,0 +1,193 @@
+package matrices
+
+import (
+	"math"
+	"math/big"
+
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	gcvops "github.com/NumberXNumbers/types/gc/values/ops"
+)
+
+// DefaultTolerance tells RREFTol to choose its tolerance from the size and largest element of the matrix
+const DefaultTolerance = -1.0
+
+// magnitude returns the absolute value of value as a float64
+func magnitude(value gcv.Value) float64 { return gcvops.Abs(value).Real() }
+
+// defaultTolerance returns the tolerance below which elements of matrix are treated as zero
+func defaultTolerance(matrix Matrix) float64 {
+	rows, cols := matrix.Dim()
+	largest := 0.0
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			largest = math.Max(largest, magnitude(matrix.Get(i, j)))
+		}
+	}
+	return math.Max(float64(rows), float64(cols)) * largest * 2.220446049250313e-16
+}
+
+// RREFTol returns the reduced row echelon form of matrix and the indices of its pivot columns.
+// Elements whose absolute value is no more than tolerance are treated as zero when choosing pivots.
+// A tolerance of DefaultTolerance, or any negative tolerance, is chosen from the size and largest element
+// of matrix. A tolerance of 0 is the exact mode of RREFExact
+func RREFTol(matrix Matrix, tolerance float64) (rref Matrix, pivots []int) {
+	switch {
+	case tolerance == 0:
+		return RREFExact(matrix)
+	case tolerance < 0:
+		tolerance = defaultTolerance(matrix)
+	}
+	return rrefTol(matrix, tolerance)
+}
+
+// rrefTol reduces matrix by Gauss Jordan elimination with partial pivoting, treating elements
+// whose absolute value is no more than tolerance as zero
+func rrefTol(matrix Matrix, tolerance float64) (rref Matrix, pivots []int) {
+	rref = matrix.Copy()
+	rows, cols := rref.Dim()
+
+	pivotRow := 0
+	for col := 0; col < cols && pivotRow < rows; col++ {
+		best, bestMagnitude := pivotRow, magnitude(rref.Get(pivotRow, col))
+		for i := pivotRow + 1; i < rows; i++ {
+			if size := magnitude(rref.Get(i, col)); size > bestMagnitude {
+				best, bestMagnitude = i, size
+			}
+		}
+		if bestMagnitude <= tolerance {
+			for i := pivotRow; i < rows; i++ {
+				rref.Set(i, col, gcv.Zero())
+			}
+			continue
+		}
+		if best != pivotRow {
+			rref.Swap(best, pivotRow)
+		}
+
+		pivot := rref.Get(pivotRow, col)
+		for j := col + 1; j < cols; j++ {
+			rref.Set(pivotRow, j, gcvops.Div(rref.Get(pivotRow, j), pivot))
+		}
+		rref.Set(pivotRow, col, gcv.MakeValue(1))
+
+		for i := 0; i < rows; i++ {
+			factor := rref.Get(i, col)
+			if i == pivotRow || factor.IsZero() {
+				continue
+			}
+			for j := col + 1; j < cols; j++ {
+				rref.Set(i, j, gcvops.Sub(rref.Get(i, j), gcvops.Mult(factor, rref.Get(pivotRow, j))))
+			}
+			rref.Set(i, col, gcv.Zero())
+		}
+
+		pivots = append(pivots, col)
+		pivotRow++
+	}
+	return rref, pivots
+}
+
+// isFinite returns true if no element of the Real matrix is an Inf or NaN
+func isFinite(matrix Matrix) bool {
+	rows, cols := matrix.Dim()
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			if x := matrix.Get(i, j).Real(); math.IsInf(x, 0) || math.IsNaN(x) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// maxDenominator is the largest denominator rational takes a float64 to be a fraction of
+const maxDenominator = 1 << 20
+
+// rational returns the fraction of the smallest denominator, up to maxDenominator, found among the
+// continued fraction convergents of x that rounds to x, so 1.0/3 is 1/3 and 0.1 is 1/10.
+// If there is none, x is returned exactly
+func rational(x float64) *big.Rat {
+	exact := new(big.Rat).SetFloat64(x)
+	limit := big.NewInt(maxDenominator)
+	h, hPrev := big.NewInt(1), big.NewInt(0)
+	k, kPrev := big.NewInt(0), big.NewInt(1)
+	remainder := new(big.Rat).Set(exact)
+	for {
+		// a is the floor of remainder, as Euclidean division by a positive denominator rounds down
+		a := new(big.Int).Div(remainder.Num(), remainder.Denom())
+		h, hPrev = new(big.Int).Add(new(big.Int).Mul(a, h), hPrev), h
+		k, kPrev = new(big.Int).Add(new(big.Int).Mul(a, k), kPrev), k
+		if k.Cmp(limit) > 0 {
+			return exact
+		}
+		convergent := new(big.Rat).SetFrac(h, k)
+		if f, _ := convergent.Float64(); f == x {
+			return convergent
+		}
+		remainder.Sub(remainder, new(big.Rat).SetInt(a))
+		if remainder.Sign() == 0 {
+			return exact
+		}
+		remainder.Inv(remainder)
+	}
+}
+
+// RREFExact returns the reduced row echelon form of matrix and the indices of its pivot columns,
+// using exact rational arithmetic for Real matrices. Each element is taken to be the fraction of
+// smallest denominator that rounds to it, so rational input such as 1.0/3 is reduced as 1/3 and
+// integers and fractions with a power of 2 denominator are reduced exactly. No pivot is lost to rounding.
+// The result is rounded to the nearest float64 once at the end.
+// Matrices that are not Real, or that hold an Inf or NaN, are reduced treating only elements that
+// are exactly zero as zero
+func RREFExact(matrix Matrix) (rref Matrix, pivots []int) {
+	if matrix.Type() != gcv.Real || !isFinite(matrix) {
+		return rrefTol(matrix, 0)
+	}
+
+	rows, cols := matrix.Dim()
+	rats := make([][]*big.Rat, rows)
+	for i := range rats {
+		rats[i] = make([]*big.Rat, cols)
+		for j := range rats[i] {
+			rats[i][j] = rational(matrix.Get(i, j).Real())
+		}
+	}
+
+	pivotRow := 0
+	for col := 0; col < cols && pivotRow < rows; col++ {
+		best := pivotRow
+		for best < rows && rats[best][col].Sign() == 0 {
+			best++
+		}
+		if best == rows {
+			continue
+		}
+		rats[best], rats[pivotRow] = rats[pivotRow], rats[best]
+
+		pivot := new(big.Rat).Set(rats[pivotRow][col])
+		for j := col; j < cols; j++ {
+			rats[pivotRow][j].Quo(rats[pivotRow][j], pivot)
+		}
+		for i := 0; i < rows; i++ {
+			factor := new(big.Rat).Set(rats[i][col])
+			if i == pivotRow || factor.Sign() == 0 {
+				continue
+			}
+			for j := col; j < cols; j++ {
+				rats[i][j].Sub(rats[i][j], new(big.Rat).Mul(factor, rats[pivotRow][j]))
+			}
+		}
+
+		pivots = append(pivots, col)
+		pivotRow++
+	}
+
+	rref = NewMatrix(rows, cols)
+	for i := range rats {
+		for j, rat := range rats[i] {
+			value, _ := rat.Float64()
+			rref.Set(i, j, value)
+		}
+	}
+	return rref, pivots
+}
@@ -0,0 +1,143 @@
+package matrices
+
+import (
+	"math"
+	"math/big"
+	"math/cmplx"
+	"reflect"
+	"testing"
+
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+// sameElements returns true if matrixA and matrixB have the same dimensions and element values to within 1e-12
+func sameElements(matrixA Matrix, matrixB Matrix) bool {
+	rowsA, colsA := matrixA.Dim()
+	rowsB, colsB := matrixB.Dim()
+	if rowsA != rowsB || colsA != colsB {
+		return false
+	}
+	for i := 0; i < rowsA; i++ {
+		for j := 0; j < colsA; j++ {
+			if cmplx.Abs(matrixA.Get(i, j).Complex()-matrixB.Get(i, j).Complex()) > 1e-12 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestRREF(t *testing.T) {
+	testMatrix := MakeMatrix(
+		v.MakeVector(v.RowSpace, 1, 2, 1, 4),
+		v.MakeVector(v.RowSpace, 2, 4, 0, 6),
+		v.MakeVector(v.RowSpace, 3, 6, 1, 10))
+
+	expected := MakeMatrix(
+		v.MakeVector(v.RowSpace, 1, 2, 0, 3),
+		v.MakeVector(v.RowSpace, 0, 0, 1, 1),
+		v.MakeVector(v.RowSpace, 0, 0, 0, 0))
+
+	rref, pivots := RREFExact(testMatrix)
+	if !sameElements(rref, expected) {
+		t.Errorf("Expected %v, received %v", expected, rref)
+	}
+	if !reflect.DeepEqual(pivots, []int{0, 2}) {
+		t.Errorf("Expected %v, received %v", []int{0, 2}, pivots)
+	}
+
+	if !sameElements(testMatrix.RREF(), expected) {
+		t.Errorf("Expected %v, received %v", expected, testMatrix.RREF())
+	}
+
+	if !sameElements(NewIdentityMatrix(3).RREF(), NewIdentityMatrix(3)) {
+		t.Errorf("Expected the identity, received %v", NewIdentityMatrix(3).RREF())
+	}
+}
+
+func TestRREFTolerance(t *testing.T) {
+	testMatrix := MakeMatrix(
+		v.MakeVector(v.RowSpace, 1, 1),
+		v.MakeVector(v.RowSpace, 1, 1+1e-12))
+
+	if _, pivots := RREFExact(testMatrix); len(pivots) != 2 {
+		t.Errorf("Expected 2 pivots in exact mode, received %v", pivots)
+	}
+
+	if _, pivots := RREFTol(testMatrix, 1e-9); len(pivots) != 1 {
+		t.Errorf("Expected 1 pivot with tolerance 1e-9, received %v", pivots)
+	}
+
+	thirds := MakeMatrix(
+		v.MakeVector(v.RowSpace, 1.0/3, 2.0/3),
+		v.MakeVector(v.RowSpace, 1, 2))
+	if _, pivots := RREFTol(thirds, DefaultTolerance); len(pivots) != 1 {
+		t.Errorf("Expected 1 pivot with the default tolerance, received %v", pivots)
+	}
+	rref, pivots := RREFExact(thirds)
+	if len(pivots) != 1 || rref.Get(0, 1).Real() != 2 || !rref.Get(1, 1).IsZero() {
+		t.Errorf("Expected [[1 2] [0 0]] with 1 pivot in exact mode, received %v, %v", rref.Elements(), pivots)
+	}
+
+	tenths := MakeMatrix(
+		v.MakeVector(v.RowSpace, 0.1, 0.3, 0.7),
+		v.MakeVector(v.RowSpace, 0.2, 0.6, 1.4))
+	if _, pivots := RREFExact(tenths); len(pivots) != 1 {
+		t.Errorf("Expected 1 pivot in exact mode, received %v", pivots)
+	}
+}
+
+func TestRational(t *testing.T) {
+	tests := []struct {
+		x        float64
+		solution *big.Rat
+	}{
+		{1.0 / 3, big.NewRat(1, 3)},
+		{-2.0 / 7, big.NewRat(-2, 7)},
+		{0.1, big.NewRat(1, 10)},
+		{0.75, big.NewRat(3, 4)},
+		{5, big.NewRat(5, 1)},
+		{0, big.NewRat(0, 1)},
+		{math.Pi, new(big.Rat).SetFloat64(math.Pi)},
+	}
+
+	for _, test := range tests {
+		if result := rational(test.x); result.Cmp(test.solution) != 0 {
+			t.Errorf("Expected %v for %v, received %v", test.solution, test.x, result)
+		}
+	}
+}
+
+func TestRREFComplex(t *testing.T) {
+	testMatrix := MakeMatrix(
+		v.MakeVector(v.RowSpace, 1i, 2),
+		v.MakeVector(v.RowSpace, 1, -2i))
+
+	rref, pivots := RREFExact(testMatrix)
+	if !reflect.DeepEqual(pivots, []int{0}) {
+		t.Fatalf("Expected %v, received %v", []int{0}, pivots)
+	}
+	if rref.Get(0, 1).Complex() != -2i || !rref.Get(1, 1).IsZero() {
+		t.Errorf("Expected [[1 -2i] [0 0]], received %v", rref.Elements())
+	}
+
+	view := MakeSubMatrixView(testMatrix, 0, 0, 2, 1)
+	if !reflect.DeepEqual(view.RREF().Get(0, 0), gcv.MakeValue(1)) {
+		t.Errorf("Expected %v, received %v", 1, view.RREF().Get(0, 0))
+	}
+}
+
+func TestRREFExactNonFinite(t *testing.T) {
+	testMatrix := MakeMatrix(
+		v.MakeVector(v.RowSpace, 2, math.Inf(1)),
+		v.MakeVector(v.RowSpace, 0, math.NaN()))
+
+	rref, pivots := RREFExact(testMatrix)
+	if !reflect.DeepEqual(pivots, []int{0, 1}) {
+		t.Errorf("Expected %v, received %v", []int{0, 1}, pivots)
+	}
+	if rref.Get(0, 0).Real() != 1 {
+		t.Errorf("Expected 1, received %v", rref.Get(0, 0))
+	}
+}
@@ -0,0 +1,314 @@
+package matrices
+
+import (
+	"errors"
+
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	gcvops "github.com/NumberXNumbers/types/gc/values/ops"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+// lazyMatrix is a Matrix that reads its elements through get and writes them through set,
+// so it can alias the storage of another Matrix instead of copying it
+type lazyMatrix struct {
+	numRows int
+	numCols int
+	get     func(row, col int) gcv.Value
+	set     func(row, col int, value gcv.Value)
+}
+
+func (l *lazyMatrix) checkIndex(row, col int) {
+	if row < 0 || row >= l.numRows || col < 0 || col >= l.numCols {
+		panic("Index out of range of matrix view")
+	}
+}
+
+// implementation of Dim method
+func (l *lazyMatrix) Dim() (rows, cols int) { return l.numRows, l.numCols }
+
+// implementation of TotalElements method
+func (l *lazyMatrix) TotalElements() int { return l.numRows * l.numCols }
+
+// implementation of IsSquare method
+func (l *lazyMatrix) IsSquare() bool { return l.numRows == l.numCols }
+
+// implementation of GetNumRows method
+func (l *lazyMatrix) GetNumRows() int { return l.numRows }
+
+// implementation of GetNumCols method
+func (l *lazyMatrix) GetNumCols() int { return l.numCols }
+
+// implementation of Get method
+func (l *lazyMatrix) Get(row int, col int) gcv.Value {
+	l.checkIndex(row, col)
+	return l.get(row, col)
+}
+
+// implementation of Set method
+func (l *lazyMatrix) Set(row int, col int, value interface{}) {
+	l.checkIndex(row, col)
+	l.set(row, col, gcv.MakeValue(value))
+}
+
+// implementation of Type method
+func (l *lazyMatrix) Type() gcv.Type {
+	coreType := gcv.Real
+	for i := 0; i < l.numRows; i++ {
+		for j := 0; j < l.numCols; j++ {
+			if valueType := l.get(i, j).Type(); coreType < valueType {
+				coreType = valueType
+			}
+		}
+	}
+	return coreType
+}
+
+// implementation of IsIdentity method
+func (l *lazyMatrix) IsIdentity() bool {
+	if !l.IsSquare() {
+		return false
+	}
+	for i := 0; i < l.numRows; i++ {
+		for j := 0; j < l.numCols; j++ {
+			value := l.get(i, j)
+			if (i == j && value.Complex() != 1) || (i != j && value.Complex() != 0) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// implementation of Trans method. The view is transposed, the elements are not moved
+func (l *lazyMatrix) Trans() {
+	get, set := l.get, l.set
+	l.get = func(row, col int) gcv.Value { return get(col, row) }
+	l.set = func(row, col int, value gcv.Value) { set(col, row, value) }
+	l.numRows, l.numCols = l.numCols, l.numRows
+}
+
+// implementation of Conj method
+func (l *lazyMatrix) Conj() {
+	for i := 0; i < l.numRows; i++ {
+		for j := 0; j < l.numCols; j++ {
+			l.set(i, j, gcvops.Conj(l.get(i, j)))
+		}
+	}
+}
+
+// implementation of ConjTrans method
+func (l *lazyMatrix) ConjTrans() {
+	l.Conj()
+	l.Trans()
+}
+
+// implementation of Copy method. The copy does not alias the viewed storage
+func (l *lazyMatrix) Copy() Matrix {
+	matrix := NewMatrix(l.numRows, l.numCols)
+	for i := 0; i < l.numRows; i++ {
+		for j := 0; j < l.numCols; j++ {
+			matrix.Set(i, j, l.get(i, j))
+		}
+	}
+	return matrix
+}
+
+// implementation of Elements method. The Vectors returned are a copy of the elements
+func (l *lazyMatrix) Elements() v.Vectors { return l.Copy().Elements() }
+
+// implementation of Tr method
+func (l *lazyMatrix) Tr() (gcv.Value, error) {
+	trace := gcv.Zero()
+	if !l.IsSquare() {
+		return trace, errors.New("Matrix is not square")
+	}
+	for i := 0; i < l.numRows; i++ {
+		trace = gcvops.Add(trace, l.get(i, i))
+	}
+	return trace, nil
+}
+
+// implementation of Swap method
+func (l *lazyMatrix) Swap(rowA, rowB int) {
+	for j := 0; j < l.numCols; j++ {
+		valueA, valueB := l.Get(rowA, j), l.Get(rowB, j)
+		l.set(rowA, j, valueB)
+		l.set(rowB, j, valueA)
+	}
+}
+
+// implementation of Det method
+func (l *lazyMatrix) Det() (gcv.Value, error) { return l.Copy().Det() }
+
+// implementation of Inv method
+func (l *lazyMatrix) Inv() (Matrix, error) { return l.Copy().Inv() }
+
+// implementation of Aug method
+func (l *lazyMatrix) Aug(b interface{}) Matrix { return l.Copy().Aug(b) }
+
+// implementation of Trim method. Use MakeSubMatrixView for a sub matrix that is not a copy
+func (l *lazyMatrix) Trim(top, bottom, left, right int) Matrix {
+	return l.Copy().Trim(top, bottom, left, right)
+}
+
+// implementation of RREF method
+func (l *lazyMatrix) RREF() Matrix {
+	rref, _ := RREFTol(l, DefaultTolerance)
+	return rref
+}
+
+// lazyVector is a Vector that reads its elements through get and writes them through set,
+// so it can alias the storage of a Matrix instead of copying it
+type lazyVector struct {
+	length int
+	space  v.Space
+	get    func(index int) gcv.Value
+	set    func(index int, value gcv.Value)
+}
+
+func (l *lazyVector) checkIndex(index int) {
+	if index < 0 || index >= l.length {
+		panic("Index out of range of vector view")
+	}
+}
+
+// implementation of Len method
+func (l *lazyVector) Len() int { return l.length }
+
+// implementation of Space method
+func (l *lazyVector) Space() v.Space { return l.space }
+
+// implementation of Get method
+func (l *lazyVector) Get(index int) gcv.Value {
+	l.checkIndex(index)
+	return l.get(index)
+}
+
+// implementation of Set method
+func (l *lazyVector) Set(index int, val gcv.Value) {
+	l.checkIndex(index)
+	l.set(index, val)
+}
+
+// implementation of Type method
+func (l *lazyVector) Type() gcv.Type { return l.Elements().Type() }
+
+// implementation of Elements method. The Values returned are a copy of the elements
+func (l *lazyVector) Elements() gcv.Values {
+	values := gcv.NewValues(l.length)
+	for i := 0; i < l.length; i++ {
+		values.Set(i, l.get(i))
+	}
+	return values
+}
+
+// implementation of Copy method. The copy does not alias the viewed storage
+func (l *lazyVector) Copy() v.Vector { return v.MakeVectorAlt(l.space, l.Elements()) }
+
+// implementation of IndexOf method
+func (l *lazyVector) IndexOf(val gcv.Value) int { return l.Elements().IndexOf(val) }
+
+// implementation of Norm method
+func (l *lazyVector) Norm() gcv.Value { return l.Copy().Norm() }
+
+// implementation of Unit method
+func (l *lazyVector) Unit() (v.Vector, error) { return l.Copy().Unit() }
+
+// implementation of Trans method
+func (l *lazyVector) Trans() {
+	if l.space == v.ColSpace {
+		l.space = v.RowSpace
+	} else {
+		l.space = v.ColSpace
+	}
+}
+
+// implementation of Conj method
+func (l *lazyVector) Conj() {
+	for i := 0; i < l.length; i++ {
+		l.set(i, gcvops.Conj(l.get(i)))
+	}
+}
+
+// implementation of ConjTrans method
+func (l *lazyVector) ConjTrans() {
+	l.Conj()
+	l.Trans()
+}
+
+// implementation of Append method. Panics, a view can not change the length of the viewed matrix
+func (l *lazyVector) Append(val gcv.Value) { panic("Can not append to a vector view") }
+
+// setter returns a set function that writes value to matrix at (row, col)
+func setter(matrix Matrix) func(row, col int, value gcv.Value) {
+	return func(row, col int, value gcv.Value) { matrix.Set(row, col, value) }
+}
+
+// MakeSubMatrixView returns the rows by cols sub matrix of matrix whose top left element is (row, col).
+// The view shares its elements with matrix, setting one sets the other.
+// Views of a matrix are not valid after the matrix is transposed.
+// Panics if the sub matrix does not fit inside matrix
+func MakeSubMatrixView(matrix Matrix, row, col, rows, cols int) Matrix {
+	if row < 0 || col < 0 || rows < 0 || cols < 0 || row+rows > matrix.GetNumRows() || col+cols > matrix.GetNumCols() {
+		panic("Requested dimensions are greater than dimensions of primary matrix")
+	}
+	set := setter(matrix)
+	return &lazyMatrix{
+		numRows: rows,
+		numCols: cols,
+		get:     func(i, j int) gcv.Value { return matrix.Get(i+row, j+col) },
+		set:     func(i, j int, value gcv.Value) { set(i+row, j+col, value) },
+	}
+}
+
+// MakeTransView returns the transpose of matrix, sharing its elements with matrix
+func MakeTransView(matrix Matrix) Matrix {
+	view := MakeSubMatrixView(matrix, 0, 0, matrix.GetNumRows(), matrix.GetNumCols())
+	view.Trans()
+	return view
+}
+
+// MakeRowView returns row of matrix as a RowSpace Vector, sharing its elements with matrix.
+// Panics if row is out of range
+func MakeRowView(matrix Matrix, row int) v.Vector {
+	if row < 0 || row >= matrix.GetNumRows() {
+		panic("Row out of range of matrix")
+	}
+	set := setter(matrix)
+	return &lazyVector{
+		length: matrix.GetNumCols(),
+		space:  v.RowSpace,
+		get:    func(index int) gcv.Value { return matrix.Get(row, index) },
+		set:    func(index int, value gcv.Value) { set(row, index, value) },
+	}
+}
+
+// MakeColView returns col of matrix as a ColSpace Vector, sharing its elements with matrix.
+// Panics if col is out of range
+func MakeColView(matrix Matrix, col int) v.Vector {
+	if col < 0 || col >= matrix.GetNumCols() {
+		panic("Column out of range of matrix")
+	}
+	set := setter(matrix)
+	return &lazyVector{
+		length: matrix.GetNumRows(),
+		space:  v.ColSpace,
+		get:    func(index int) gcv.Value { return matrix.Get(index, col) },
+		set:    func(index int, value gcv.Value) { set(index, col, value) },
+	}
+}
+
+// MakeDiagView returns the main diagonal of matrix as a ColSpace Vector, sharing its elements with matrix
+func MakeDiagView(matrix Matrix) v.Vector {
+	length := matrix.GetNumRows()
+	if cols := matrix.GetNumCols(); cols < length {
+		length = cols
+	}
+	set := setter(matrix)
+	return &lazyVector{
+		length: length,
+		space:  v.ColSpace,
+		get:    func(index int) gcv.Value { return matrix.Get(index, index) },
+		set:    func(index int, value gcv.Value) { set(index, index, value) },
+	}
+}
@@ -0,0 +1,105 @@
+package matrices
+
+import (
+	"reflect"
+	"testing"
+
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+func makeViewTestMatrix() Matrix {
+	return MakeMatrix(
+		v.MakeVector(v.RowSpace, 1, 2, 3),
+		v.MakeVector(v.RowSpace, 4, 5, 6),
+		v.MakeVector(v.RowSpace, 7, 8, 9))
+}
+
+func TestSubMatrixView(t *testing.T) {
+	testMatrix := makeViewTestMatrix()
+	view := MakeSubMatrixView(testMatrix, 1, 1, 2, 2)
+
+	if rows, cols := view.Dim(); rows != 2 || cols != 2 {
+		t.Fatalf("Expected (2, 2), received (%d, %d)", rows, cols)
+	}
+
+	if !reflect.DeepEqual(view.Copy(), testMatrix.Trim(1, 0, 1, 0)) {
+		t.Errorf("Expected %v, received %v", testMatrix.Trim(1, 0, 1, 0), view.Copy())
+	}
+
+	view.Set(0, 1, 10)
+	if testMatrix.Get(1, 2).Real() != 10 {
+		t.Errorf("Expected %v, received %v", 10, testMatrix.Get(1, 2))
+	}
+
+	testMatrix.Set(2, 2, 2+1i)
+	if view.Get(1, 1).Complex() != 2+1i || view.Type() != gcv.Complex {
+		t.Errorf("Expected %v, received %v", 2+1i, view.Get(1, 1))
+	}
+
+	det, err := view.Det()
+	if err != nil || det.Complex() != 5*(2+1i)-80 {
+		t.Errorf("Expected %v, received %v", 5*(2+1i)-80, det)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for index outside of the view")
+		}
+	}()
+	view.Get(2, 0)
+}
+
+func TestTransView(t *testing.T) {
+	testMatrix := MakeMatrix(v.MakeVector(v.RowSpace, 1, 2, 3), v.MakeVector(v.RowSpace, 4, 5, 6))
+	view := MakeTransView(testMatrix)
+
+	if !reflect.DeepEqual(view.Copy(), MakeTransMatrix(testMatrix)) {
+		t.Errorf("Expected %v, received %v", MakeTransMatrix(testMatrix), view.Copy())
+	}
+
+	view.Set(2, 0, 30)
+	if testMatrix.Get(0, 2).Real() != 30 {
+		t.Errorf("Expected %v, received %v", 30, testMatrix.Get(0, 2))
+	}
+
+	view.Trans()
+	if !reflect.DeepEqual(view.Copy(), testMatrix) {
+		t.Errorf("Expected %v, received %v", testMatrix, view.Copy())
+	}
+}
+
+func TestRowColAndDiagView(t *testing.T) {
+	testMatrix := makeViewTestMatrix()
+
+	row := MakeRowView(testMatrix, 1)
+	if row.Space() != v.RowSpace || !reflect.DeepEqual(row.Copy(), v.MakeVector(v.RowSpace, 4, 5, 6)) {
+		t.Errorf("Expected %v, received %v", v.MakeVector(v.RowSpace, 4, 5, 6), row.Copy())
+	}
+
+	col := MakeColView(testMatrix, 2)
+	if col.Space() != v.ColSpace || !reflect.DeepEqual(col.Copy(), v.MakeVector(v.ColSpace, 3, 6, 9)) {
+		t.Errorf("Expected %v, received %v", v.MakeVector(v.ColSpace, 3, 6, 9), col.Copy())
+	}
+
+	diag := MakeDiagView(MakeSubMatrixView(testMatrix, 0, 0, 3, 2))
+	if diag.Len() != 2 || diag.Get(1).Real() != 5 {
+		t.Errorf("Expected diagonal [1 5], received %v", diag.Elements())
+	}
+
+	col.Set(1, gcv.MakeValue(60))
+	if testMatrix.Get(1, 2).Real() != 60 || row.Get(2).Real() != 60 {
+		t.Errorf("Expected %v, received %v", 60, testMatrix.Get(1, 2))
+	}
+
+	if row.IndexOf(gcv.MakeValue(60)) != 2 {
+		t.Errorf("Expected %v, received %v", 2, row.IndexOf(gcv.MakeValue(60)))
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for append to a view")
+		}
+	}()
+	row.Append(gcv.MakeValue(1))
+}
@@ -9,7 +9,7 @@ import (
 func sMult(scalar gcv.Value, vector v.Vector) v.Vector {
 	newVector := v.NewVector(vector.Space(), vector.Len())
 	for i := 0; i < vector.Len(); i++ {
-		newVector.Set(i, gcv.MakeValue(scalar.Complex()*vector.Get(i).Complex()))
+		newVector.Set(i, gcvops.Mult(scalar, vector.Get(i)))
 	}
 
 	return newVector
@@ -0,0 +1,315 @@
+package sets
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	gcv "github.com/NumberXNumbers/types/gc/values"
+)
+
+// Bound is the kind of endpoint of an Interval. Either Open or Closed.
+type Bound int
+
+const (
+	// Open is for an endpoint that is not in the Interval
+	Open Bound = iota
+	// Closed is for an endpoint that is in the Interval
+	Closed
+)
+
+// Interval is a connected set of real numbers
+type Interval interface {
+	// Returns the lower endpoint of the Interval
+	Lower() float64
+
+	// Returns the upper endpoint of the Interval
+	Upper() float64
+
+	// Returns the Bound of the lower endpoint
+	LowerBound() Bound
+
+	// Returns the Bound of the upper endpoint
+	UpperBound() Bound
+
+	// Returns true if the Interval has no elements
+	IsEmpty() bool
+
+	// Returns true if val is Real and in the Interval
+	Contains(val gcv.Value) bool
+
+	// Returns the length of the Interval
+	Len() float64
+
+	// String will return the string representation of the Interval, i.e [0, 1)
+	String() string
+}
+
+type interval struct {
+	lower      float64
+	upper      float64
+	lowerBound Bound
+	upperBound Bound
+}
+
+func (i *interval) Lower() float64 { return i.lower }
+
+func (i *interval) Upper() float64 { return i.upper }
+
+func (i *interval) LowerBound() Bound { return i.lowerBound }
+
+func (i *interval) UpperBound() Bound { return i.upperBound }
+
+func (i *interval) IsEmpty() bool {
+	return i.lower > i.upper || (i.lower == i.upper && (i.lowerBound == Open || i.upperBound == Open))
+}
+
+func (i *interval) Contains(val gcv.Value) bool {
+	if val.Type() != gcv.Real || i.IsEmpty() {
+		return false
+	}
+	x := val.Real()
+	aboveLower := x > i.lower || (x == i.lower && i.lowerBound == Closed)
+	belowUpper := x < i.upper || (x == i.upper && i.upperBound == Closed)
+	return aboveLower && belowUpper
+}
+
+func (i *interval) Len() float64 {
+	if i.IsEmpty() {
+		return 0
+	}
+	return i.upper - i.lower
+}
+
+func (i *interval) String() string {
+	if i.IsEmpty() {
+		return "{}"
+	}
+	left, right := "(", ")"
+	if i.lowerBound == Closed {
+		left = "["
+	}
+	if i.upperBound == Closed {
+		right = "]"
+	}
+	return fmt.Sprintf("%s%s, %s%s", left, formatEndpoint(i.lower), formatEndpoint(i.upper), right)
+}
+
+func formatEndpoint(x float64) string {
+	if math.IsInf(x, 1) {
+		return "Inf"
+	} else if math.IsInf(x, -1) {
+		return "-Inf"
+	}
+	return strconv.FormatFloat(x, 'g', -1, 64)
+}
+
+// MakeInterval returns an Interval from lower to upper. Infinite endpoints are always Open.
+// If lower is greater than upper the empty Interval is returned
+func MakeInterval(lower float64, upper float64, lowerBound Bound, upperBound Bound) Interval {
+	interval := new(interval)
+	interval.lower = lower
+	interval.upper = upper
+	interval.lowerBound = lowerBound
+	interval.upperBound = upperBound
+	if math.IsInf(lower, 0) {
+		interval.lowerBound = Open
+	}
+	if math.IsInf(upper, 0) {
+		interval.upperBound = Open
+	}
+	return interval
+}
+
+// MakeClosedInterval returns the Interval [lower, upper]
+func MakeClosedInterval(lower float64, upper float64) Interval {
+	return MakeInterval(lower, upper, Closed, Closed)
+}
+
+// MakeOpenInterval returns the Interval (lower, upper)
+func MakeOpenInterval(lower float64, upper float64) Interval {
+	return MakeInterval(lower, upper, Open, Open)
+}
+
+// IntervalSet is a set of real numbers made up of disjoint Intervals
+type IntervalSet interface {
+	// Returns the disjoint Intervals of the set ordered from lowest to highest
+	Intervals() []Interval
+
+	// Returns true if the set has no elements
+	IsEmpty() bool
+
+	// Returns true if val is Real and in the set
+	Contains(val gcv.Value) bool
+
+	// Returns the total length of the Intervals in the set
+	Len() float64
+
+	// Returns a new set of the real numbers in either set
+	Union(set IntervalSet) IntervalSet
+
+	// Returns a new set of the real numbers in both sets
+	Intersection(set IntervalSet) IntervalSet
+
+	// Returns a new set of the real numbers in this set but not in set
+	Difference(set IntervalSet) IntervalSet
+
+	// Returns a new set of the real numbers in exactly one of the sets
+	SymmetricDifference(set IntervalSet) IntervalSet
+
+	// Returns a new set of the real numbers not in this set
+	Complement() IntervalSet
+
+	// Returns true if every real number in this set is in set
+	IsSubset(set IntervalSet) bool
+
+	// Returns true if both sets hold the same real numbers
+	Equal(set IntervalSet) bool
+
+	// String will return the string representation of the set, i.e [0, 1) U (2, 3]
+	String() string
+}
+
+type intervalSet struct {
+	intervals []Interval
+}
+
+func (s *intervalSet) Intervals() []Interval {
+	intervals := make([]Interval, len(s.intervals))
+	copy(intervals, s.intervals)
+	return intervals
+}
+
+func (s *intervalSet) IsEmpty() bool { return len(s.intervals) == 0 }
+
+func (s *intervalSet) Contains(val gcv.Value) bool {
+	for _, interval := range s.intervals {
+		if interval.Contains(val) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *intervalSet) Len() float64 {
+	var length float64
+	for _, interval := range s.intervals {
+		length += interval.Len()
+	}
+	return length
+}
+
+func (s *intervalSet) Union(set IntervalSet) IntervalSet {
+	return MakeIntervalSet(append(s.Intervals(), set.Intervals()...)...)
+}
+
+func (s *intervalSet) Intersection(set IntervalSet) IntervalSet {
+	var intervals []Interval
+	for _, intervalA := range s.intervals {
+		for _, intervalB := range set.Intervals() {
+			intervals = append(intervals, intersectIntervals(intervalA, intervalB))
+		}
+	}
+	return MakeIntervalSet(intervals...)
+}
+
+func (s *intervalSet) Difference(set IntervalSet) IntervalSet {
+	return s.Intersection(set.Complement())
+}
+
+func (s *intervalSet) SymmetricDifference(set IntervalSet) IntervalSet {
+	return s.Difference(set).Union(set.Difference(s))
+}
+
+func (s *intervalSet) Complement() IntervalSet {
+	var intervals []Interval
+	lower, lowerBound := math.Inf(-1), Open
+	for _, interval := range s.intervals {
+		intervals = append(intervals, MakeInterval(lower, interval.Lower(), lowerBound, flipBound(interval.LowerBound())))
+		lower, lowerBound = interval.Upper(), flipBound(interval.UpperBound())
+	}
+	intervals = append(intervals, MakeInterval(lower, math.Inf(1), lowerBound, Open))
+	return MakeIntervalSet(intervals...)
+}
+
+func (s *intervalSet) IsSubset(set IntervalSet) bool { return s.Difference(set).IsEmpty() }
+
+func (s *intervalSet) Equal(set IntervalSet) bool { return s.IsSubset(set) && set.IsSubset(s) }
+
+func (s *intervalSet) String() string {
+	if s.IsEmpty() {
+		return "{}"
+	}
+	intervals := make([]string, len(s.intervals))
+	for index, interval := range s.intervals {
+		intervals[index] = interval.String()
+	}
+	return strings.Join(intervals, " U ")
+}
+
+func flipBound(bound Bound) Bound {
+	if bound == Open {
+		return Closed
+	}
+	return Open
+}
+
+func intersectIntervals(intervalA Interval, intervalB Interval) Interval {
+	lower, lowerBound := intervalA.Lower(), intervalA.LowerBound()
+	if intervalB.Lower() > lower || (intervalB.Lower() == lower && intervalB.LowerBound() == Open) {
+		lower, lowerBound = intervalB.Lower(), intervalB.LowerBound()
+	}
+	upper, upperBound := intervalA.Upper(), intervalA.UpperBound()
+	if intervalB.Upper() < upper || (intervalB.Upper() == upper && intervalB.UpperBound() == Open) {
+		upper, upperBound = intervalB.Upper(), intervalB.UpperBound()
+	}
+	return MakeInterval(lower, upper, lowerBound, upperBound)
+}
+
+// byLower sorts Intervals by where they start
+type byLower []Interval
+
+func (b byLower) Len() int { return len(b) }
+
+func (b byLower) Swap(i, j int) { b[i], b[j] = b[j], b[i] }
+
+func (b byLower) Less(i, j int) bool {
+	if b[i].Lower() != b[j].Lower() {
+		return b[i].Lower() < b[j].Lower()
+	}
+	return b[i].LowerBound() == Closed && b[j].LowerBound() == Open
+}
+
+// MakeIntervalSet returns the IntervalSet holding the union of intervals.
+// Overlapping and touching Intervals are merged together
+func MakeIntervalSet(intervals ...Interval) IntervalSet {
+	var nonEmpty []Interval
+	for _, interval := range intervals {
+		if !interval.IsEmpty() {
+			nonEmpty = append(nonEmpty, interval)
+		}
+	}
+	sort.Stable(byLower(nonEmpty))
+
+	set := new(intervalSet)
+	for _, interval := range nonEmpty {
+		last := len(set.intervals) - 1
+		if last < 0 {
+			set.intervals = append(set.intervals, interval)
+			continue
+		}
+		previous := set.intervals[last]
+		touches := interval.Lower() < previous.Upper() ||
+			(interval.Lower() == previous.Upper() && (interval.LowerBound() == Closed || previous.UpperBound() == Closed))
+		if !touches {
+			set.intervals = append(set.intervals, interval)
+			continue
+		}
+		if interval.Upper() > previous.Upper() || (interval.Upper() == previous.Upper() && interval.UpperBound() == Closed) {
+			set.intervals[last] = MakeInterval(previous.Lower(), interval.Upper(), previous.LowerBound(), interval.UpperBound())
+		}
+	}
+	return set
+}
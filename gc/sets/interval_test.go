@@ -0,0 +1,96 @@
+package sets
+
+import (
+	"math"
+	"testing"
+
+	gcv "github.com/NumberXNumbers/types/gc/values"
+)
+
+func TestInterval(t *testing.T) {
+	testIntervalA := MakeInterval(0, 1, Closed, Open)
+
+	if !testIntervalA.Contains(gcv.MakeValue(0)) || testIntervalA.Contains(gcv.MakeValue(1)) ||
+		testIntervalA.Contains(gcv.MakeValue(0.5+1i)) || !testIntervalA.Contains(gcv.MakeValue(0.5)) {
+		t.Error("Failure: Contains")
+	}
+
+	if testIntervalA.String() != "[0, 1)" || testIntervalA.Len() != 1 {
+		t.Errorf("Expected [0, 1), received %v", testIntervalA)
+	}
+
+	testIntervalB := MakeClosedInterval(math.Inf(-1), 2)
+	if testIntervalB.LowerBound() != Open || testIntervalB.String() != "(-Inf, 2]" {
+		t.Errorf("Expected (-Inf, 2], received %v", testIntervalB)
+	}
+
+	if !MakeOpenInterval(1, 1).IsEmpty() || MakeClosedInterval(1, 1).IsEmpty() || !MakeClosedInterval(2, 1).IsEmpty() {
+		t.Error("Failure: IsEmpty")
+	}
+
+	if MakeOpenInterval(1, 1).String() != "{}" || MakeOpenInterval(2, 1).Len() != 0 || MakeOpenInterval(1, 1).Contains(gcv.MakeValue(1)) {
+		t.Error("Failure: Empty Interval")
+	}
+}
+
+func TestMakeIntervalSet(t *testing.T) {
+	testSet := MakeIntervalSet(
+		MakeInterval(2, 3, Open, Closed),
+		MakeInterval(0, 1, Closed, Open),
+		MakeInterval(1, 1.5, Closed, Open),
+		MakeOpenInterval(5, 5),
+		MakeOpenInterval(3, 4))
+
+	if testSet.String() != "[0, 1.5) U (2, 4)" {
+		t.Errorf("Expected [0, 1.5) U (2, 4), received %v", testSet)
+	}
+
+	if len(testSet.Intervals()) != 2 || testSet.Len() != 3.5 || testSet.IsEmpty() {
+		t.Errorf("Expected %v, received %v", 3.5, testSet.Len())
+	}
+
+	if !testSet.Contains(gcv.MakeValue(3)) || testSet.Contains(gcv.MakeValue(1.5)) || testSet.Contains(gcv.MakeValue(2)) {
+		t.Error("Failure: Contains")
+	}
+
+	if MakeIntervalSet(MakeOpenInterval(0, 1), MakeOpenInterval(1, 2)).String() != "(0, 1) U (1, 2)" {
+		t.Error("Failure: Open endpoints should not merge")
+	}
+
+	if MakeIntervalSet().String() != "{}" {
+		t.Error("Failure: Empty set")
+	}
+}
+
+func TestIntervalSetOperations(t *testing.T) {
+	testSetA := MakeIntervalSet(MakeClosedInterval(0, 2))
+	testSetB := MakeIntervalSet(MakeOpenInterval(1, 3))
+
+	tests := []struct {
+		name     string
+		result   IntervalSet
+		solution string
+	}{
+		{"Union", testSetA.Union(testSetB), "[0, 3)"},
+		{"Intersection", testSetA.Intersection(testSetB), "(1, 2]"},
+		{"Difference", testSetA.Difference(testSetB), "[0, 1]"},
+		{"SymmetricDifference", testSetA.SymmetricDifference(testSetB), "[0, 1] U (2, 3)"},
+		{"Complement", testSetA.Complement(), "(-Inf, 0) U (2, Inf)"},
+		{"Complement of empty", MakeIntervalSet().Complement(), "(-Inf, Inf)"},
+		{"Complement of everything", MakeIntervalSet().Complement().Complement(), "{}"},
+	}
+
+	for _, test := range tests {
+		if test.result.String() != test.solution {
+			t.Errorf("%v: Expected %v, received %v", test.name, test.solution, test.result)
+		}
+	}
+
+	if !testSetA.Intersection(testSetB).IsSubset(testSetA) || testSetA.IsSubset(testSetB) {
+		t.Error("Failure: IsSubset")
+	}
+
+	if !testSetA.Complement().Complement().Equal(testSetA) || testSetA.Equal(testSetB) {
+		t.Error("Failure: Equal")
+	}
+}
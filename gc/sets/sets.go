@@ -0,0 +1,37 @@
+// Package sets holds finite sets of Values and Vectors, and sets of real intervals.
+//
+// Membership in the finite sets is decided with a tolerance. Two elements are taken to be
+// equal when they are no more than the set's tolerance apart, so a set never holds two
+// elements that are within tolerance of each other.
+package sets
+
+import (
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	gcvops "github.com/NumberXNumbers/types/gc/values/ops"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+func valuesEqual(valueA gcv.Value, valueB gcv.Value, tolerance float64) bool {
+	return gcvops.Abs(gcvops.Sub(valueA, valueB)).Real() <= tolerance
+}
+
+func vectorsEqual(vectorA v.Vector, vectorB v.Vector, tolerance float64) bool {
+	if vectorA.Space() != vectorB.Space() || vectorA.Len() != vectorB.Len() {
+		return false
+	}
+	for i := 0; i < vectorA.Len(); i++ {
+		if !valuesEqual(vectorA.Get(i), vectorB.Get(i), tolerance) {
+			return false
+		}
+	}
+	return true
+}
+
+// valuesOf returns the Values in vals, with the zero Value in place of any unset index
+func valuesOf(vals gcv.Values) []gcv.Value {
+	values := make([]gcv.Value, vals.Len())
+	for i := range values {
+		values[i] = vals.Get(i)
+	}
+	return values
+}
@@ -0,0 +1,215 @@
+package sets
+
+import (
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+// ValueSet is a finite set of gcv Values
+type ValueSet interface {
+	// Returns true if a Value within tolerance of val is in the set
+	Contains(val gcv.Value) bool
+
+	// Add val to the set if it is not already in the set
+	Add(val gcv.Value)
+
+	// Remove the Value within tolerance of val from the set
+	Remove(val gcv.Value)
+
+	// Returns the number of Values in the set
+	Len() int
+
+	// Returns the Values in the set, in the order they were added
+	Values() gcv.Values
+
+	// Returns the tolerance used to decide if two Values are equal
+	Tolerance() float64
+
+	// Returns a copy of the set
+	Copy() ValueSet
+
+	// Returns a new set of the Values in either set
+	Union(set ValueSet) ValueSet
+
+	// Returns a new set of the Values in both sets
+	Intersection(set ValueSet) ValueSet
+
+	// Returns a new set of the Values in this set but not in set
+	Difference(set ValueSet) ValueSet
+
+	// Returns a new set of the Values in exactly one of the sets
+	SymmetricDifference(set ValueSet) ValueSet
+
+	// Returns true if every Value in this set is in set
+	IsSubset(set ValueSet) bool
+
+	// Returns true if this set is a subset of set and set has a Value this set does not
+	IsProperSubset(set ValueSet) bool
+
+	// Returns true if both sets hold the same Values
+	Equal(set ValueSet) bool
+
+	// Returns every subset of the set. The number of subsets is 2^Len()
+	PowerSet() []ValueSet
+}
+
+type valueSet struct {
+	vals      []gcv.Value
+	tolerance float64
+}
+
+func (s *valueSet) indexOf(val gcv.Value) int {
+	for index, value := range s.vals {
+		if valuesEqual(value, val, s.tolerance) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (s *valueSet) Contains(val gcv.Value) bool { return s.indexOf(val) != -1 }
+
+func (s *valueSet) Add(val gcv.Value) {
+	if !s.Contains(val) {
+		s.vals = append(s.vals, val)
+	}
+}
+
+func (s *valueSet) Remove(val gcv.Value) {
+	if index := s.indexOf(val); index != -1 {
+		s.vals = append(s.vals[:index], s.vals[index+1:]...)
+	}
+}
+
+func (s *valueSet) Len() int { return len(s.vals) }
+
+func (s *valueSet) Values() gcv.Values {
+	vals := make([]gcv.Value, len(s.vals))
+	copy(vals, s.vals)
+	return gcv.MakeValuesAlt(vals)
+}
+
+func (s *valueSet) Tolerance() float64 { return s.tolerance }
+
+func (s *valueSet) Copy() ValueSet {
+	set := newValueSet(s.tolerance)
+	set.vals = append(set.vals, s.vals...)
+	return set
+}
+
+func (s *valueSet) Union(set ValueSet) ValueSet {
+	union := s.Copy()
+	for _, val := range valuesOf(set.Values()) {
+		union.Add(val)
+	}
+	return union
+}
+
+func (s *valueSet) Intersection(set ValueSet) ValueSet {
+	intersection := newValueSet(s.tolerance)
+	for _, val := range s.vals {
+		if set.Contains(val) {
+			intersection.Add(val)
+		}
+	}
+	return intersection
+}
+
+func (s *valueSet) Difference(set ValueSet) ValueSet {
+	difference := newValueSet(s.tolerance)
+	for _, val := range s.vals {
+		if !set.Contains(val) {
+			difference.Add(val)
+		}
+	}
+	return difference
+}
+
+func (s *valueSet) SymmetricDifference(set ValueSet) ValueSet {
+	return s.Difference(set).Union(set.Difference(s))
+}
+
+func (s *valueSet) IsSubset(set ValueSet) bool {
+	for _, val := range s.vals {
+		if !set.Contains(val) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *valueSet) IsProperSubset(set ValueSet) bool {
+	return s.IsSubset(set) && set.Difference(s).Len() > 0
+}
+
+func (s *valueSet) Equal(set ValueSet) bool {
+	return s.IsSubset(set) && set.IsSubset(s)
+}
+
+func (s *valueSet) PowerSet() []ValueSet {
+	powerSet := []ValueSet{newValueSet(s.tolerance)}
+	for _, val := range s.vals {
+		for _, subset := range powerSet {
+			newSubset := subset.Copy()
+			newSubset.Add(val)
+			powerSet = append(powerSet, newSubset)
+		}
+	}
+	return powerSet
+}
+
+func newValueSet(tolerance float64) *valueSet {
+	set := new(valueSet)
+	set.vals = make([]gcv.Value, 0)
+	set.tolerance = tolerance
+	return set
+}
+
+// NewValueSet returns an empty ValueSet
+func NewValueSet(tolerance float64) ValueSet {
+	return newValueSet(tolerance)
+}
+
+// MakeValueSetAlt returns a ValueSet, but requires a framework gcv Values type
+func MakeValueSetAlt(tolerance float64, vals gcv.Values) ValueSet {
+	set := newValueSet(tolerance)
+	for _, val := range valuesOf(vals) {
+		set.Add(val)
+	}
+	return set
+}
+
+// MakeValueSet returns a ValueSet, takes in a slice of interfaces.
+// in an interface is not a supported type, that interface will be forced to the zero
+// Value
+func MakeValueSet(tolerance float64, vals ...interface{}) ValueSet {
+	return MakeValueSetAlt(tolerance, gcv.MakeValues(vals...))
+}
+
+// CartesianProduct returns the set of every row Vector whose i-th element is taken from
+// the i-th set. The tolerance of the product is the tolerance of the first set.
+func CartesianProduct(sets ...ValueSet) VectorSet {
+	var tolerance float64
+	if len(sets) != 0 {
+		tolerance = sets[0].Tolerance()
+	}
+
+	tuples := [][]gcv.Value{{}}
+	for _, set := range sets {
+		var newTuples [][]gcv.Value
+		for _, tuple := range tuples {
+			for _, val := range valuesOf(set.Values()) {
+				newTuple := make([]gcv.Value, len(tuple), len(tuple)+1)
+				copy(newTuple, tuple)
+				newTuples = append(newTuples, append(newTuple, val))
+			}
+		}
+		tuples = newTuples
+	}
+
+	product := newVectorSet(tolerance)
+	for _, tuple := range tuples {
+		product.Add(v.MakeVectorAlt(v.RowSpace, gcv.MakeValuesAlt(tuple)))
+	}
+	return product
+}
@@ -0,0 +1,101 @@
+package sets
+
+import (
+	"testing"
+
+	gcv "github.com/NumberXNumbers/types/gc/values"
+)
+
+func TestMakeValueSet(t *testing.T) {
+	testSet := MakeValueSet(1e-9, 1, 2, 2, 1+1e-12, 0, 3i)
+
+	if testSet.Len() != 4 {
+		t.Errorf("Expected %v, received %v", 4, testSet.Len())
+	}
+
+	if !testSet.Contains(gcv.Zero()) || !testSet.Contains(gcv.MakeValue(3i)) || testSet.Contains(gcv.MakeValue(3)) {
+		t.Error("Failure: Contains")
+	}
+
+	testSet.Remove(gcv.MakeValue(2))
+	testSet.Add(gcv.MakeValue(3i))
+	if testSet.Len() != 3 || testSet.Contains(gcv.MakeValue(2)) {
+		t.Errorf("Expected %v, received %v", 3, testSet.Len())
+	}
+
+	if NewValueSet(0).Len() != 0 || testSet.Tolerance() != 1e-9 {
+		t.Fail()
+	}
+}
+
+func TestValueSetOperations(t *testing.T) {
+	testSetA := MakeValueSet(1e-9, 1, 2, 3)
+	testSetB := MakeValueSet(1e-9, 2, 3, 4)
+
+	if union := testSetA.Union(testSetB); !union.Equal(MakeValueSet(0, 1, 2, 3, 4)) {
+		t.Errorf("Failure: Union, received %v", union.Values())
+	}
+
+	if intersection := testSetA.Intersection(testSetB); !intersection.Equal(MakeValueSet(0, 2, 3)) {
+		t.Errorf("Failure: Intersection, received %v", intersection.Values())
+	}
+
+	if difference := testSetA.Difference(testSetB); !difference.Equal(MakeValueSet(0, 1)) {
+		t.Errorf("Failure: Difference, received %v", difference.Values())
+	}
+
+	if difference := testSetA.SymmetricDifference(testSetB); !difference.Equal(MakeValueSet(0, 1, 4)) {
+		t.Errorf("Failure: SymmetricDifference, received %v", difference.Values())
+	}
+
+	testSetC := MakeValueSet(1e-9, 2, 3)
+	if !testSetC.IsSubset(testSetA) || !testSetC.IsProperSubset(testSetA) || testSetA.IsSubset(testSetC) {
+		t.Error("Failure: IsSubset")
+	}
+
+	if !testSetA.IsSubset(testSetA.Copy()) || testSetA.IsProperSubset(testSetA.Copy()) {
+		t.Error("Failure: IsProperSubset")
+	}
+}
+
+func TestValueSetPowerSet(t *testing.T) {
+	testSet := MakeValueSet(1e-9, 1, 2, 3)
+	powerSet := testSet.PowerSet()
+
+	if len(powerSet) != 8 {
+		t.Fatalf("Expected %v, received %v", 8, len(powerSet))
+	}
+
+	for i, subsetA := range powerSet {
+		if !subsetA.IsSubset(testSet) {
+			t.Errorf("Expected %v to be a subset", subsetA.Values())
+		}
+		for j, subsetB := range powerSet {
+			if i != j && subsetA.Equal(subsetB) {
+				t.Errorf("Duplicate subset %v", subsetA.Values())
+			}
+		}
+	}
+}
+
+func TestCartesianProduct(t *testing.T) {
+	testSetA := MakeValueSet(1e-9, 1, 2)
+	testSetB := MakeValueSet(1e-9, 0, 3, 4)
+
+	product := CartesianProduct(testSetA, testSetB)
+	if product.Len() != 6 {
+		t.Fatalf("Expected %v, received %v", 6, product.Len())
+	}
+
+	for _, a := range gcv.RetrieveValues(testSetA.Values()) {
+		for _, b := range []interface{}{0, 3, 4} {
+			if !product.Contains(vector(a, b)) {
+				t.Errorf("Expected (%v, %v) in product", a, b)
+			}
+		}
+	}
+
+	if empty := CartesianProduct(testSetA, NewValueSet(0)); empty.Len() != 0 {
+		t.Errorf("Expected %v, received %v", 0, empty.Len())
+	}
+}
@@ -0,0 +1,185 @@
+package sets
+
+import v "github.com/NumberXNumbers/types/gc/vectors"
+
+// VectorSet is a finite set of Vectors.
+// Two Vectors are only equal if they are in the same Space and have the same length.
+type VectorSet interface {
+	// Returns true if a Vector within tolerance of vect is in the set
+	Contains(vect v.Vector) bool
+
+	// Add a copy of vect to the set if it is not already in the set
+	Add(vect v.Vector)
+
+	// Remove the Vector within tolerance of vect from the set
+	Remove(vect v.Vector)
+
+	// Returns the number of Vectors in the set
+	Len() int
+
+	// Returns copies of the Vectors in the set, in the order they were added
+	Vectors() []v.Vector
+
+	// Returns the tolerance used to decide if two Vectors are equal
+	Tolerance() float64
+
+	// Returns a copy of the set
+	Copy() VectorSet
+
+	// Returns a new set of the Vectors in either set
+	Union(set VectorSet) VectorSet
+
+	// Returns a new set of the Vectors in both sets
+	Intersection(set VectorSet) VectorSet
+
+	// Returns a new set of the Vectors in this set but not in set
+	Difference(set VectorSet) VectorSet
+
+	// Returns a new set of the Vectors in exactly one of the sets
+	SymmetricDifference(set VectorSet) VectorSet
+
+	// Returns true if every Vector in this set is in set
+	IsSubset(set VectorSet) bool
+
+	// Returns true if this set is a subset of set and set has a Vector this set does not
+	IsProperSubset(set VectorSet) bool
+
+	// Returns true if both sets hold the same Vectors
+	Equal(set VectorSet) bool
+
+	// Returns every subset of the set. The number of subsets is 2^Len()
+	PowerSet() []VectorSet
+}
+
+type vectorSet struct {
+	vects     []v.Vector
+	tolerance float64
+}
+
+func (s *vectorSet) indexOf(vect v.Vector) int {
+	for index, vector := range s.vects {
+		if vectorsEqual(vector, vect, s.tolerance) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (s *vectorSet) Contains(vect v.Vector) bool { return s.indexOf(vect) != -1 }
+
+func (s *vectorSet) Add(vect v.Vector) {
+	if !s.Contains(vect) {
+		s.vects = append(s.vects, vect.Copy())
+	}
+}
+
+func (s *vectorSet) Remove(vect v.Vector) {
+	if index := s.indexOf(vect); index != -1 {
+		s.vects = append(s.vects[:index], s.vects[index+1:]...)
+	}
+}
+
+func (s *vectorSet) Len() int { return len(s.vects) }
+
+func (s *vectorSet) Vectors() []v.Vector {
+	vects := make([]v.Vector, len(s.vects))
+	for index, vect := range s.vects {
+		vects[index] = vect.Copy()
+	}
+	return vects
+}
+
+func (s *vectorSet) Tolerance() float64 { return s.tolerance }
+
+func (s *vectorSet) Copy() VectorSet {
+	set := newVectorSet(s.tolerance)
+	set.vects = append(set.vects, s.vects...)
+	return set
+}
+
+func (s *vectorSet) Union(set VectorSet) VectorSet {
+	union := s.Copy()
+	for _, vect := range set.Vectors() {
+		union.Add(vect)
+	}
+	return union
+}
+
+func (s *vectorSet) Intersection(set VectorSet) VectorSet {
+	intersection := newVectorSet(s.tolerance)
+	for _, vect := range s.vects {
+		if set.Contains(vect) {
+			intersection.Add(vect)
+		}
+	}
+	return intersection
+}
+
+func (s *vectorSet) Difference(set VectorSet) VectorSet {
+	difference := newVectorSet(s.tolerance)
+	for _, vect := range s.vects {
+		if !set.Contains(vect) {
+			difference.Add(vect)
+		}
+	}
+	return difference
+}
+
+func (s *vectorSet) SymmetricDifference(set VectorSet) VectorSet {
+	return s.Difference(set).Union(set.Difference(s))
+}
+
+func (s *vectorSet) IsSubset(set VectorSet) bool {
+	for _, vect := range s.vects {
+		if !set.Contains(vect) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *vectorSet) IsProperSubset(set VectorSet) bool {
+	return s.IsSubset(set) && set.Difference(s).Len() > 0
+}
+
+func (s *vectorSet) Equal(set VectorSet) bool {
+	return s.IsSubset(set) && set.IsSubset(s)
+}
+
+func (s *vectorSet) PowerSet() []VectorSet {
+	powerSet := []VectorSet{newVectorSet(s.tolerance)}
+	for _, vect := range s.vects {
+		for _, subset := range powerSet {
+			newSubset := subset.Copy()
+			newSubset.Add(vect)
+			powerSet = append(powerSet, newSubset)
+		}
+	}
+	return powerSet
+}
+
+func newVectorSet(tolerance float64) *vectorSet {
+	set := new(vectorSet)
+	set.vects = make([]v.Vector, 0)
+	set.tolerance = tolerance
+	return set
+}
+
+// NewVectorSet returns an empty VectorSet
+func NewVectorSet(tolerance float64) VectorSet {
+	return newVectorSet(tolerance)
+}
+
+// MakeVectorSetAlt returns a VectorSet, but requires a framework Vectors type
+func MakeVectorSetAlt(tolerance float64, vects v.Vectors) VectorSet {
+	return MakeVectorSet(tolerance, vects.Vectors()...)
+}
+
+// MakeVectorSet returns a VectorSet of vects
+func MakeVectorSet(tolerance float64, vects ...v.Vector) VectorSet {
+	set := newVectorSet(tolerance)
+	for _, vect := range vects {
+		set.Add(vect)
+	}
+	return set
+}
@@ -0,0 +1,69 @@
+package sets
+
+import (
+	"testing"
+
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+func vector(elements ...interface{}) v.Vector {
+	return v.MakeVector(v.RowSpace, elements...)
+}
+
+func TestMakeVectorSet(t *testing.T) {
+	testSet := MakeVectorSet(1e-9, vector(1, 2), vector(1, 2+1e-12), vector(2, 1), vector(1, 2, 0))
+
+	if testSet.Len() != 3 {
+		t.Errorf("Expected %v, received %v", 3, testSet.Len())
+	}
+
+	colVector := vector(1, 2)
+	colVector.Trans()
+	if testSet.Contains(colVector) || !testSet.Contains(vector(2, 1)) {
+		t.Error("Failure: Contains")
+	}
+
+	testSet.Remove(vector(2, 1))
+	if testSet.Len() != 2 || testSet.Contains(vector(2, 1)) {
+		t.Errorf("Expected %v, received %v", 2, testSet.Len())
+	}
+
+	vectors := testSet.Vectors()
+	vectors[0].Set(0, vector(5).Get(0))
+	if !testSet.Contains(vector(1, 2)) {
+		t.Error("Expected Vectors to return copies")
+	}
+
+	if !MakeVectorSetAlt(0, v.MakeVectors(v.RowSpace, vectors...)).IsSubset(testSet.Union(MakeVectorSet(0, vector(5, 2)))) {
+		t.Error("Failure: MakeVectorSetAlt")
+	}
+}
+
+func TestVectorSetOperations(t *testing.T) {
+	testSetA := MakeVectorSet(1e-9, vector(1, 0), vector(0, 1))
+	testSetB := MakeVectorSet(1e-9, vector(0, 1), vector(1, 1))
+
+	if union := testSetA.Union(testSetB); union.Len() != 3 || !testSetA.IsProperSubset(union) {
+		t.Errorf("Failure: Union, received %v", union.Vectors())
+	}
+
+	if intersection := testSetA.Intersection(testSetB); !intersection.Equal(MakeVectorSet(0, vector(0, 1))) {
+		t.Errorf("Failure: Intersection, received %v", intersection.Vectors())
+	}
+
+	if difference := testSetA.Difference(testSetB); !difference.Equal(MakeVectorSet(0, vector(1, 0))) {
+		t.Errorf("Failure: Difference, received %v", difference.Vectors())
+	}
+
+	if difference := testSetA.SymmetricDifference(testSetB); !difference.Equal(MakeVectorSet(0, vector(1, 0), vector(1, 1))) {
+		t.Errorf("Failure: SymmetricDifference, received %v", difference.Vectors())
+	}
+
+	if testSetA.IsSubset(testSetB) || testSetA.Copy().IsProperSubset(testSetA) || NewVectorSet(0).Tolerance() != 0 {
+		t.Error("Failure: IsSubset")
+	}
+
+	if len(testSetA.Union(testSetB).PowerSet()) != 8 {
+		t.Error("Failure: PowerSet")
+	}
+}
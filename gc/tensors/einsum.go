@@ -0,0 +1,158 @@
+package tensors
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	gcvops "github.com/NumberXNumbers/types/gc/values/ops"
+)
+
+// isLabel returns true if r can name an index in an Einsum subscript
+func isLabel(r rune) bool { return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') }
+
+// parseSubscripts splits an Einsum subscript, such as "ij,jk->ik", into the labels of each
+// operand and of the output. Without "->" the output is every label used once, in alphabetical order
+func parseSubscripts(subscripts string) ([][]rune, []rune, error) {
+	subscripts = strings.Replace(subscripts, " ", "", -1)
+	parts := strings.Split(subscripts, "->")
+	if len(parts) > 2 {
+		return nil, nil, fmt.Errorf("Subscripts %q have more than one ->", subscripts)
+	}
+
+	var operands [][]rune
+	counts := make(map[rune]int)
+	for _, operand := range strings.Split(parts[0], ",") {
+		labels := []rune(operand)
+		for _, label := range labels {
+			if !isLabel(label) {
+				return nil, nil, fmt.Errorf("Subscripts %q have invalid index %q", subscripts, label)
+			}
+			counts[label]++
+		}
+		operands = append(operands, labels)
+	}
+
+	var output []rune
+	if len(parts) == 2 {
+		output = []rune(parts[1])
+		seen := make(map[rune]bool)
+		for _, label := range output {
+			if !isLabel(label) || counts[label] == 0 || seen[label] {
+				return nil, nil, fmt.Errorf("Subscripts %q have invalid output index %q", subscripts, label)
+			}
+			seen[label] = true
+		}
+	} else {
+		for label, count := range counts {
+			if count == 1 {
+				output = append(output, label)
+			}
+		}
+		sort.Sort(runes(output))
+	}
+	return operands, output, nil
+}
+
+type runes []rune
+
+func (r runes) Len() int           { return len(r) }
+func (r runes) Less(i, j int) bool { return r[i] < r[j] }
+func (r runes) Swap(i, j int)      { r[i], r[j] = r[j], r[i] }
+
+// Einsum returns the Einstein summation of tensors described by subscripts, such as "ij,jk->ik"
+// for the matrix product, "ii->" for the trace, "i,j->ij" for the outer product or "ijk->kji"
+// to permute. Each operand has one letter per axis. Letters left out of the output, after "->",
+// are summed over. Without "->" the output is every letter used once, in alphabetical order.
+// Returns error if subscripts do not match the tensors or a letter is given axes of different lengths
+func Einsum(subscripts string, tensors ...Tensor) (Tensor, error) {
+	operands, output, err := parseSubscripts(subscripts)
+	if err != nil {
+		return nil, err
+	}
+	if len(operands) != len(tensors) {
+		return nil, fmt.Errorf("Subscripts %q are for %d tensors, not %d", subscripts, len(operands), len(tensors))
+	}
+
+	lengths := make(map[rune]int)
+	var labels []rune
+	for index, operand := range operands {
+		shape := tensors[index].Shape()
+		if len(operand) != len(shape) {
+			return nil, fmt.Errorf("Subscript %q does not match tensor of rank %d", string(operand), len(shape))
+		}
+		for axis, label := range operand {
+			length, ok := lengths[label]
+			if !ok {
+				lengths[label] = shape[axis]
+				labels = append(labels, label)
+			} else if length != shape[axis] {
+				return nil, fmt.Errorf("Index %q has lengths %d and %d", label, length, shape[axis])
+			}
+		}
+	}
+
+	// loop over the output labels first, then the labels summed over
+	inOutput := make(map[rune]bool)
+	for _, label := range output {
+		inOutput[label] = true
+	}
+	order := append([]rune{}, output...)
+	for _, label := range labels {
+		if !inOutput[label] {
+			order = append(order, label)
+		}
+	}
+	position := make(map[rune]int)
+	shape := make([]int, len(order))
+	for index, label := range order {
+		position[label] = index
+		shape[index] = lengths[label]
+	}
+
+	result := NewTensor(shape[:len(output)]...)
+	operandIndices := make([][]int, len(tensors))
+	for index, operand := range operands {
+		operandIndices[index] = make([]int, len(operand))
+	}
+	forEachIndex(shape, func(indices []int) {
+		product := gcv.MakeValue(1)
+		for index, operand := range operands {
+			for axis, label := range operand {
+				operandIndices[index][axis] = indices[position[label]]
+			}
+			product = gcvops.Mult(product, tensors[index].Get(operandIndices[index]...))
+		}
+		outputIndices := indices[:len(output)]
+		result.Set(outputIndices, gcvops.Add(result.Get(outputIndices...), product))
+	})
+	return result, nil
+}
+
+// MustEinsum is the same as Einsum but will panic
+func MustEinsum(subscripts string, tensors ...Tensor) Tensor {
+	result, err := Einsum(subscripts, tensors...)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Outer returns the outer product of tensors, a Tensor whose shape is each of their shapes in turn
+func Outer(tensors ...Tensor) Tensor {
+	if len(tensors) == 0 {
+		return MustMakeTensor(nil, 1)
+	}
+	result := tensors[0].Copy()
+	for _, t := range tensors[1:] {
+		shape := append(result.Shape(), t.Shape()...)
+		product := NewTensor(shape...)
+		rank := result.Rank()
+		forEachIndex(shape, func(indices []int) {
+			product.Set(indices, gcvops.Mult(result.Get(indices[:rank]...), t.Get(indices[rank:]...)))
+		})
+		result = product
+	}
+	return result
+}
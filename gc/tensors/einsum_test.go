@@ -0,0 +1,90 @@
+package tensors
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEinsum(t *testing.T) {
+	a := MustMakeTensor([]int{2, 3}, 1, 2, 3, 4, 5, 6)
+	b := MustMakeTensor([]int{3, 2}, 7, 8, 9, 10, 11, 12)
+	square := MustMakeTensor([]int{2, 2}, 1, 2, 3, 4)
+	x := MustMakeTensor([]int{2}, 1, 2)
+	y := MustMakeTensor([]int{3}, 3, 4, 5)
+
+	testCases := []struct {
+		subscripts string
+		tensors    []Tensor
+		shape      []int
+		elements   []float64
+	}{
+		{"ij,jk->ik", []Tensor{a, b}, []int{2, 2}, []float64{58, 64, 139, 154}},
+		{"ij,jk", []Tensor{a, b}, []int{2, 2}, []float64{58, 64, 139, 154}},
+		{"ii->", []Tensor{square}, []int{}, []float64{5}},
+		{"ii->i", []Tensor{square}, []int{2}, []float64{1, 4}},
+		{"ij->ji", []Tensor{a}, []int{3, 2}, []float64{1, 4, 2, 5, 3, 6}},
+		{"ij->", []Tensor{a}, []int{}, []float64{21}},
+		{"i,j->ij", []Tensor{x, y}, []int{2, 3}, []float64{3, 4, 5, 6, 8, 10}},
+		{"i,i->", []Tensor{x, x}, []int{}, []float64{5}},
+		{"ij,j->i", []Tensor{a, y}, []int{2}, []float64{26, 62}},
+	}
+
+	for _, testCase := range testCases {
+		result, err := Einsum(testCase.subscripts, testCase.tensors...)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", testCase.subscripts, err)
+			continue
+		}
+		if !reflect.DeepEqual(result.Shape(), testCase.shape) {
+			t.Errorf("%s: expected shape %v, received %v", testCase.subscripts, testCase.shape, result.Shape())
+			continue
+		}
+		for index, element := range testCase.elements {
+			if result.Elements().Get(index).Real() != element {
+				t.Errorf("%s: expected %v, received %v", testCase.subscripts, testCase.elements, result.Elements())
+				break
+			}
+		}
+	}
+}
+
+func TestEinsumErrors(t *testing.T) {
+	a := MustMakeTensor([]int{2, 3}, 1, 2, 3, 4, 5, 6)
+
+	testCases := []struct {
+		subscripts string
+		tensors    []Tensor
+	}{
+		{"ij,jk->ik", []Tensor{a}},
+		{"ijk->i", []Tensor{a}},
+		{"ij,ij->i", []Tensor{a, MustMakeTensor([]int{3, 2}, 1, 2, 3, 4, 5, 6)}},
+		{"ij->k", []Tensor{a}},
+		{"ij->ii", []Tensor{a}},
+		{"i1->i", []Tensor{a}},
+		{"ij->i->j", []Tensor{a}},
+	}
+
+	for _, testCase := range testCases {
+		if _, err := Einsum(testCase.subscripts, testCase.tensors...); err == nil {
+			t.Errorf("%s: expected error", testCase.subscripts)
+		}
+	}
+}
+
+func TestOuter(t *testing.T) {
+	x := MustMakeTensor([]int{2}, 1, 2)
+	a := MustMakeTensor([]int{2, 2}, 1, 2, 3, 4)
+
+	result := Outer(x, a)
+	if !reflect.DeepEqual(result.Shape(), []int{2, 2, 2}) {
+		t.Fatalf("Expected %v, received %v", []int{2, 2, 2}, result.Shape())
+	}
+	if result.Get(1, 1, 0).Real() != 6 {
+		t.Errorf("Expected %v, received %v", 6, result.Get(1, 1, 0))
+	}
+
+	expected := MustEinsum("i,jk->ijk", x, a)
+	if !reflect.DeepEqual(result.Elements(), expected.Elements()) {
+		t.Errorf("Expected %v, received %v", expected.Elements(), result.Elements())
+	}
+}
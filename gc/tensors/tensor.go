@@ -0,0 +1,254 @@
+package tensors
+
+import (
+	"errors"
+	"fmt"
+
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+// Tensor is an n dimensional array of gcv Values, stored in row major order
+type Tensor interface {
+	// Returns the number of indices of the tensor
+	Rank() int
+
+	// Returns a copy of the length of each axis
+	Shape() []int
+
+	// Returns a copy of the strides, the step through Elements for one step along each axis
+	Strides() []int
+
+	// Returns the total number of elements
+	Len() int
+
+	// Returns the Value at indices. Panics if there are not Rank indices or one is out of range
+	Get(indices ...int) gcv.Value
+
+	// Set the Value val at indices. Panics if there are not Rank indices or one is out of range
+	Set(indices []int, val gcv.Value)
+
+	// Returns the elements in row major order
+	Elements() gcv.Values
+
+	// Returns the highest ranking Type of the elements
+	Type() gcv.Type
+
+	// Returns a copy of the tensor
+	Copy() Tensor
+
+	// Returns a new tensor with its axes reordered, axis i of the result is axis axes[i] of the tensor.
+	// Returns error if axes is not a permutation of 0 to Rank-1
+	Permute(axes ...int) (Tensor, error)
+
+	// Returns a new tensor with the same elements in row major order and a new shape.
+	// Returns error if the number of elements does not match
+	Reshape(shape ...int) (Tensor, error)
+}
+
+type tensor struct {
+	shape    []int
+	strides  []int
+	elements gcv.Values
+}
+
+func (t *tensor) Rank() int { return len(t.shape) }
+
+func (t *tensor) Shape() []int { return append([]int{}, t.shape...) }
+
+func (t *tensor) Strides() []int { return append([]int{}, t.strides...) }
+
+func (t *tensor) Len() int { return t.elements.Len() }
+
+func (t *tensor) offset(indices []int) int {
+	if len(indices) != t.Rank() {
+		panic(fmt.Sprintf("Tensor of rank %d indexed with %d indices", t.Rank(), len(indices)))
+	}
+	offset := 0
+	for axis, index := range indices {
+		if index < 0 || index >= t.shape[axis] {
+			panic(fmt.Sprintf("Index %d out of range for axis %d of length %d", index, axis, t.shape[axis]))
+		}
+		offset += index * t.strides[axis]
+	}
+	return offset
+}
+
+func (t *tensor) Get(indices ...int) gcv.Value { return t.elements.Get(t.offset(indices)) }
+
+func (t *tensor) Set(indices []int, val gcv.Value) { t.elements.Set(t.offset(indices), val) }
+
+func (t *tensor) Elements() gcv.Values { return t.elements }
+
+func (t *tensor) Type() gcv.Type { return t.elements.Type() }
+
+func (t *tensor) Copy() Tensor {
+	return &tensor{shape: t.Shape(), strides: t.Strides(), elements: t.elements.Copy()}
+}
+
+func (t *tensor) Permute(axes ...int) (Tensor, error) {
+	if len(axes) != t.Rank() {
+		return nil, errors.New("Number of axes does not match the rank of the Tensor")
+	}
+	seen := make([]bool, t.Rank())
+	shape := make([]int, t.Rank())
+	for i, axis := range axes {
+		if axis < 0 || axis >= t.Rank() || seen[axis] {
+			return nil, errors.New("Axes are not a permutation")
+		}
+		seen[axis] = true
+		shape[i] = t.shape[axis]
+	}
+
+	permuted := NewTensor(shape...)
+	indices := make([]int, t.Rank())
+	forEachIndex(shape, func(newIndices []int) {
+		for i, axis := range axes {
+			indices[axis] = newIndices[i]
+		}
+		permuted.Set(newIndices, t.Get(indices...))
+	})
+	return permuted, nil
+}
+
+func (t *tensor) Reshape(shape ...int) (Tensor, error) {
+	if err := checkShape(shape); err != nil {
+		return nil, err
+	}
+	if size(shape) != t.Len() {
+		return nil, errors.New("Shape does not match the number of elements")
+	}
+	return &tensor{shape: append([]int{}, shape...), strides: rowMajorStrides(shape), elements: t.elements.Copy()}, nil
+}
+
+// size returns the number of elements of a tensor of shape
+func size(shape []int) int {
+	total := 1
+	for _, length := range shape {
+		total *= length
+	}
+	return total
+}
+
+// rowMajorStrides returns the strides of a row major tensor of shape
+func rowMajorStrides(shape []int) []int {
+	strides := make([]int, len(shape))
+	stride := 1
+	for axis := len(shape) - 1; axis >= 0; axis-- {
+		strides[axis] = stride
+		stride *= shape[axis]
+	}
+	return strides
+}
+
+// checkShape returns error if any length of shape is negative
+func checkShape(shape []int) error {
+	for _, length := range shape {
+		if length < 0 {
+			return errors.New("Shape must not have negative lengths")
+		}
+	}
+	return nil
+}
+
+// forEachIndex calls f with every index of a tensor of shape in row major order.
+// f must not keep indices, it is reused between calls
+func forEachIndex(shape []int, f func(indices []int)) {
+	if size(shape) == 0 {
+		return
+	}
+	indices := make([]int, len(shape))
+	for {
+		f(indices)
+		axis := len(shape) - 1
+		for ; axis >= 0; axis-- {
+			indices[axis]++
+			if indices[axis] < shape[axis] {
+				break
+			}
+			indices[axis] = 0
+		}
+		if axis < 0 {
+			return
+		}
+	}
+}
+
+// NewTensor returns a zero Tensor of shape. A Tensor with no shape is a scalar with one element.
+// Panics if a length is negative
+func NewTensor(shape ...int) Tensor {
+	if err := checkShape(shape); err != nil {
+		panic(err)
+	}
+	return &tensor{shape: append([]int{}, shape...), strides: rowMajorStrides(shape), elements: gcv.NewValues(size(shape))}
+}
+
+// MakeTensorAlt returns a Tensor of shape with elements in row major order.
+// Returns error if the number of elements does not match shape
+func MakeTensorAlt(shape []int, elements gcv.Values) (Tensor, error) {
+	if err := checkShape(shape); err != nil {
+		return nil, err
+	}
+	if size(shape) != elements.Len() {
+		return nil, errors.New("Number of elements does not match shape")
+	}
+	return &tensor{shape: append([]int{}, shape...), strides: rowMajorStrides(shape), elements: elements.Copy()}, nil
+}
+
+// MakeTensor returns a Tensor of shape with elements, of any type gcv.MakeValue takes, in row major order.
+// Returns error if the number of elements does not match shape
+func MakeTensor(shape []int, elements ...interface{}) (Tensor, error) {
+	return MakeTensorAlt(shape, gcv.MakeValues(elements...))
+}
+
+// MustMakeTensor is the same as MakeTensor but will panic
+func MustMakeTensor(shape []int, elements ...interface{}) Tensor {
+	t, err := MakeTensor(shape, elements...)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// FromVector returns the rank 1 Tensor of the elements of vector
+func FromVector(vector v.Vector) Tensor {
+	t, _ := MakeTensorAlt([]int{vector.Len()}, vector.Elements())
+	return t
+}
+
+// FromMatrix returns the rank 2 Tensor of the elements of matrix, indexed by row then column
+func FromMatrix(matrix m.Matrix) Tensor {
+	t := NewTensor(matrix.Dim())
+	for i := 0; i < matrix.GetNumRows(); i++ {
+		for j := 0; j < matrix.GetNumCols(); j++ {
+			t.Set([]int{i, j}, matrix.Get(i, j))
+		}
+	}
+	return t
+}
+
+// ToVector returns the Vector in space of the elements of a rank 1 Tensor.
+// Returns error if t is not of rank 1
+func ToVector(t Tensor, space v.Space) (v.Vector, error) {
+	if t.Rank() != 1 {
+		return nil, errors.New("Tensor is not of rank 1")
+	}
+	return v.MakeVectorAlt(space, t.Elements().Copy()), nil
+}
+
+// ToMatrix returns the Matrix of the elements of a rank 2 Tensor, indexed by row then column.
+// Returns error if t is not of rank 2
+func ToMatrix(t Tensor) (m.Matrix, error) {
+	if t.Rank() != 2 {
+		return nil, errors.New("Tensor is not of rank 2")
+	}
+	shape := t.Shape()
+	matrix := m.NewMatrix(shape[0], shape[1])
+	for i := 0; i < shape[0]; i++ {
+		for j := 0; j < shape[1]; j++ {
+			matrix.Set(i, j, t.Get(i, j))
+		}
+	}
+	return matrix, nil
+}
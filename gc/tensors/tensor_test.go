@@ -0,0 +1,112 @@
+package tensors
+
+import (
+	"reflect"
+	"testing"
+
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+func TestShapeAndStridesTensor(t *testing.T) {
+	testTensor := NewTensor(2, 3, 4)
+
+	if !reflect.DeepEqual(testTensor.Shape(), []int{2, 3, 4}) {
+		t.Errorf("Expected %v, received %v", []int{2, 3, 4}, testTensor.Shape())
+	}
+
+	if !reflect.DeepEqual(testTensor.Strides(), []int{12, 4, 1}) {
+		t.Errorf("Expected %v, received %v", []int{12, 4, 1}, testTensor.Strides())
+	}
+
+	if testTensor.Rank() != 3 || testTensor.Len() != 24 {
+		t.Errorf("Expected rank 3 and 24 elements, received %d and %d", testTensor.Rank(), testTensor.Len())
+	}
+
+	scalar := NewTensor()
+	if scalar.Rank() != 0 || scalar.Len() != 1 {
+		t.Errorf("Expected rank 0 and 1 element, received %d and %d", scalar.Rank(), scalar.Len())
+	}
+}
+
+func TestGetAndSetTensor(t *testing.T) {
+	testTensor := MustMakeTensor([]int{2, 3}, 1, 2, 3, 4, 5, 6)
+
+	if testTensor.Get(1, 2).Real() != 6 {
+		t.Errorf("Expected %v, received %v", 6, testTensor.Get(1, 2))
+	}
+
+	testTensor.Set([]int{0, 1}, gcv.MakeValue(2+1i))
+	if testTensor.Get(0, 1).Complex() != 2+1i || testTensor.Type() != gcv.Complex {
+		t.Errorf("Expected %v, received %v", 2+1i, testTensor.Get(0, 1))
+	}
+
+	if _, err := MakeTensor([]int{2, 2}, 1, 2, 3); err == nil {
+		t.Error("Expected error for mismatched number of elements")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for index out of range")
+		}
+	}()
+	testTensor.Get(2, 0)
+}
+
+func TestPermuteAndReshapeTensor(t *testing.T) {
+	testTensor := MustMakeTensor([]int{2, 3}, 1, 2, 3, 4, 5, 6)
+
+	permuted, err := testTensor.Permute(1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(permuted.Shape(), []int{3, 2}) || permuted.Get(2, 1).Real() != 6 || permuted.Get(2, 0).Real() != 3 {
+		t.Errorf("Expected the transpose, received %v", permuted.Elements())
+	}
+
+	if _, err := testTensor.Permute(0, 0); err == nil {
+		t.Error("Expected error for axes that are not a permutation")
+	}
+
+	reshaped, err := testTensor.Reshape(3, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reshaped.Get(1, 0).Real() != 3 {
+		t.Errorf("Expected %v, received %v", 3, reshaped.Get(1, 0))
+	}
+
+	if _, err := testTensor.Reshape(4, 2); err == nil {
+		t.Error("Expected error for mismatched shape")
+	}
+}
+
+func TestVectorAndMatrixConversionTensor(t *testing.T) {
+	vector := v.MakeVector(v.RowSpace, 1, 2, 3)
+	vectorTensor := FromVector(vector)
+	if !reflect.DeepEqual(vectorTensor.Shape(), []int{3}) {
+		t.Errorf("Expected %v, received %v", []int{3}, vectorTensor.Shape())
+	}
+	back, err := ToVector(vectorTensor, v.RowSpace)
+	if err != nil || !reflect.DeepEqual(back, vector) {
+		t.Errorf("Expected %v, received %v", vector, back)
+	}
+
+	matrix := m.MakeMatrix(v.MakeVector(v.RowSpace, 1, 2, 3), v.MakeVector(v.RowSpace, 4, 5, 6))
+	matrixTensor := FromMatrix(matrix)
+	if !reflect.DeepEqual(matrixTensor.Shape(), []int{2, 3}) || matrixTensor.Get(1, 0).Real() != 4 {
+		t.Errorf("Expected matrix elements, received %v", matrixTensor.Elements())
+	}
+	backMatrix, err := ToMatrix(matrixTensor)
+	if err != nil || !reflect.DeepEqual(backMatrix, matrix) {
+		t.Errorf("Expected %v, received %v", matrix, backMatrix)
+	}
+
+	if _, err := ToMatrix(vectorTensor); err == nil {
+		t.Error("Expected error for a rank 1 tensor")
+	}
+	if _, err := ToVector(matrixTensor, v.ColSpace); err == nil {
+		t.Error("Expected error for a rank 2 tensor")
+	}
+}
@@ -0,0 +1,90 @@
+package values
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// IntervalValue is a Value of type Interval, the closed real interval [Lower, Upper].
+// Real returns the midpoint of the interval and Imag returns 0
+type IntervalValue interface {
+	Value
+
+	// returns the lower endpoint of the interval
+	Lower() float64
+
+	// returns the upper endpoint of the interval
+	Upper() float64
+
+	// returns the width of the interval
+	Width() float64
+
+	// returns true if the Real Value val, or every point of the Interval Value val, is in the interval
+	Contains(val Value) bool
+}
+
+type interval struct {
+	lower float64
+	upper float64
+}
+
+func (i *interval) Lower() float64 { return i.lower }
+
+func (i *interval) Upper() float64 { return i.upper }
+
+func (i *interval) Width() float64 { return i.upper - i.lower }
+
+func (i *interval) Contains(val Value) bool {
+	lower, upper := IntervalBounds(val)
+	return i.lower <= lower && upper <= i.upper
+}
+
+func (i *interval) Real() float64 {
+	switch {
+	case math.IsInf(i.lower, -1) && math.IsInf(i.upper, 1):
+		return 0
+	case math.IsInf(i.lower, 0):
+		return i.lower
+	case math.IsInf(i.upper, 0):
+		return i.upper
+	}
+	return i.lower + (i.upper-i.lower)/2
+}
+
+func (i *interval) Imag() float64 { return 0 }
+
+func (i *interval) Complex() complex128 { return complex(i.Real(), 0) }
+
+func (i *interval) Type() Type { return Interval }
+
+func (i *interval) IsZero() bool { return i.lower == 0 && i.upper == 0 }
+
+func (i *interval) String() string {
+	return fmt.Sprintf("[%s, %s]", strconv.FormatFloat(i.lower, 'g', -1, 64), strconv.FormatFloat(i.upper, 'g', -1, 64))
+}
+
+// MakeInterval returns the Interval Value [lower, upper]. The endpoints are swapped if lower is
+// greater than upper. If either endpoint is NaN the interval holds no real numbers
+func MakeInterval(lower, upper float64) Value {
+	if lower > upper {
+		lower, upper = upper, lower
+	}
+	i := new(interval)
+	i.lower = lower
+	i.upper = upper
+	return i
+}
+
+// IntervalBounds returns the lower and upper endpoints of val as an interval.
+// Real Values are the interval [val, val]. Complex and Quaternion Values are not real intervals
+// and return NaN endpoints
+func IntervalBounds(val Value) (lower, upper float64) {
+	if i, ok := val.(IntervalValue); ok {
+		return i.Lower(), i.Upper()
+	}
+	if val.Type() != Real {
+		return math.NaN(), math.NaN()
+	}
+	return val.Real(), val.Real()
+}
@@ -0,0 +1,82 @@
+package values
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMakeInterval(t *testing.T) {
+	value := MakeInterval(3, -1)
+	if value.Type() != Interval {
+		t.Errorf("Expected %v, received %v", Interval, value.Type())
+	}
+
+	interval := value.(IntervalValue)
+	if interval.Lower() != -1 || interval.Upper() != 3 {
+		t.Errorf("Expected %v, received %v", []float64{-1, 3}, []float64{interval.Lower(), interval.Upper()})
+	}
+
+	if interval.Width() != 4 {
+		t.Errorf("Expected %v, received %v", 4, interval.Width())
+	}
+
+	if value.Real() != 1 || value.Imag() != 0 || value.Complex() != 1 {
+		t.Errorf("Expected %v, received %v", 1, value.Complex())
+	}
+
+	if value.String() != "[-1, 3]" {
+		t.Errorf("Expected %s, received %s", "[-1, 3]", value.String())
+	}
+
+	if value.IsZero() || !MakeInterval(0, 0).IsZero() {
+		t.Errorf("Expected %t, received %t", false, value.IsZero())
+	}
+
+	if !interval.Contains(MakeValue(2.5)) || !interval.Contains(MakeInterval(0, 3)) {
+		t.Errorf("Expected %v to contain 2.5 and [0, 3]", value)
+	}
+
+	if interval.Contains(MakeInterval(0, 4)) || interval.Contains(MakeValue(1i)) {
+		t.Errorf("Expected %v not to contain [0, 4] or 1i", value)
+	}
+
+	halfLine := MakeInterval(2, math.Inf(1))
+	if !math.IsInf(halfLine.Real(), 1) {
+		t.Errorf("Expected %v, received %v", math.Inf(1), halfLine.Real())
+	}
+
+	if MakeInterval(math.Inf(-1), math.Inf(1)).Real() != 0 {
+		t.Errorf("Expected %v, received %v", 0, MakeInterval(math.Inf(-1), math.Inf(1)).Real())
+	}
+}
+
+func TestIntervalBounds(t *testing.T) {
+	lower, upper := IntervalBounds(MakeValue(2))
+	if lower != 2 || upper != 2 {
+		t.Errorf("Expected %v, received %v", []float64{2, 2}, []float64{lower, upper})
+	}
+
+	lower, upper = IntervalBounds(MakeValue(2 + 1i))
+	if !math.IsNaN(lower) || !math.IsNaN(upper) {
+		t.Errorf("Expected %v, received %v", math.NaN(), []float64{lower, upper})
+	}
+}
+
+func TestIntervalValues(t *testing.T) {
+	values := MakeValues(1, MakeInterval(1, 2), 1i)
+	if values.Type() != Interval {
+		t.Errorf("Expected %v, received %v", Interval, values.Type())
+	}
+
+	if index := values.IndexOf(MakeInterval(1, 2)); index != 1 {
+		t.Errorf("Expected %v, received %v", 1, index)
+	}
+
+	if index := values.IndexOf(MakeInterval(1, 1)); index != 0 {
+		t.Errorf("Expected %v, received %v", 0, index)
+	}
+
+	if index := values.IndexOf(MakeInterval(0, 3)); index != -1 {
+		t.Errorf("Expected %v, received %v", -1, index)
+	}
+}
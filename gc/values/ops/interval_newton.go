@@ -0,0 +1,87 @@
+package ops
+
+import (
+	"errors"
+	"math"
+
+	gcv "github.com/NumberXNumbers/types/gc/values"
+)
+
+// maxNewtonSteps is the most intervals IntervalNewton will look at before giving up
+const maxNewtonSteps = 10000
+
+// IntervalNewton returns Interval Values, no wider than tolerance, enclosing every root of f in
+// the Interval Value x. df must return an interval enclosing the derivative of f over an interval.
+// The returned intervals are in increasing order and neighbouring intervals may touch.
+// Returns error if x is not a real interval, tolerance is not positive or the search does not finish
+func IntervalNewton(f func(gcv.Value) gcv.Value, df func(gcv.Value) gcv.Value, x gcv.Value, tolerance float64) ([]gcv.Value, error) {
+	lower, upper := gcv.IntervalBounds(x)
+	if math.IsNaN(lower) || math.IsNaN(upper) || math.IsInf(lower, 0) || math.IsInf(upper, 0) {
+		return nil, errors.New("x must be a finite real interval")
+	}
+	if tolerance <= 0 {
+		return nil, errors.New("Tolerance must be greater than 0")
+	}
+
+	var roots []gcv.Value
+	stack := []gcv.Value{gcv.MakeInterval(lower, upper)}
+	for steps := 0; len(stack) > 0; steps++ {
+		if steps == maxNewtonSteps {
+			return nil, errors.New("Interval Newton did not finish")
+		}
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		lower, upper := gcv.IntervalBounds(current)
+		if !containsZero(f(current)) {
+			continue
+		}
+
+		mid := current.Real()
+		if upper-lower <= tolerance || mid <= lower || mid >= upper {
+			roots = append(roots, current)
+			continue
+		}
+
+		derivative := df(current)
+		if !containsZero(derivative) {
+			midpoint := gcv.MakeInterval(mid, mid)
+			newton := intervalSub(midpoint, intervalDiv(f(midpoint), derivative))
+			next, ok := intersect(current, newton)
+			if !ok {
+				continue
+			}
+			if nextLower, nextUpper := gcv.IntervalBounds(next); nextLower != lower || nextUpper != upper {
+				stack = append(stack, next)
+				continue
+			}
+		}
+		// no progress from the Newton step, so split the interval in two
+		stack = append(stack, gcv.MakeInterval(mid, upper), gcv.MakeInterval(lower, mid))
+	}
+	return roots, nil
+}
+
+// MustIntervalNewton is the same as IntervalNewton but will panic
+func MustIntervalNewton(f func(gcv.Value) gcv.Value, df func(gcv.Value) gcv.Value, x gcv.Value, tolerance float64) []gcv.Value {
+	roots, err := IntervalNewton(f, df, x, tolerance)
+	if err != nil {
+		panic(err)
+	}
+	return roots
+}
+
+func containsZero(value gcv.Value) bool {
+	lower, upper := gcv.IntervalBounds(value)
+	return lower <= 0 && upper >= 0
+}
+
+// intersect returns the intersection of two intervals and false if they do not meet
+func intersect(valueA gcv.Value, valueB gcv.Value) (gcv.Value, bool) {
+	lowerA, upperA := gcv.IntervalBounds(valueA)
+	lowerB, upperB := gcv.IntervalBounds(valueB)
+	lower, upper := math.Max(lowerA, lowerB), math.Min(upperA, upperB)
+	if lower > upper || math.IsNaN(lower) || math.IsNaN(upper) {
+		return nil, false
+	}
+	return gcv.MakeInterval(lower, upper), true
+}
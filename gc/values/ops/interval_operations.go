@@ -0,0 +1,268 @@
+package ops
+
+import (
+	"math"
+
+	gcv "github.com/NumberXNumbers/types/gc/values"
+)
+
+// Go has no control over the floating point rounding mode, so interval results are rounded
+// outwards by stepping to the neighbouring float64. Basic arithmetic is correctly rounded and
+// needs one step. The math package functions are only accurate to about an ulp, so their
+// results are widened further
+const (
+	arithmeticULPs = 1
+	functionULPs   = 4
+)
+
+var nanInterval = gcv.MakeInterval(math.NaN(), math.NaN())
+
+// isInterval returns true if any of the values are of type Interval
+func isInterval(values ...gcv.Value) bool {
+	for _, value := range values {
+		if value.Type() == gcv.Interval {
+			return true
+		}
+	}
+	return false
+}
+
+// down returns x moved ulps float64 steps towards -Inf
+func down(x float64, ulps int) float64 {
+	for i := 0; i < ulps; i++ {
+		x = math.Nextafter(x, math.Inf(-1))
+	}
+	return x
+}
+
+// up returns x moved ulps float64 steps towards +Inf
+func up(x float64, ulps int) float64 {
+	for i := 0; i < ulps; i++ {
+		x = math.Nextafter(x, math.Inf(1))
+	}
+	return x
+}
+
+// outward returns the interval [lower, upper] rounded outwards by ulps steps
+func outward(lower, upper float64, ulps int) gcv.Value {
+	if math.IsNaN(lower) || math.IsNaN(upper) {
+		return nanInterval
+	}
+	return gcv.MakeInterval(down(lower, ulps), up(upper, ulps))
+}
+
+// clamp returns the interval [lower, upper] cut down to the range [rangeLower, rangeUpper]
+func clamp(value gcv.Value, rangeLower, rangeUpper float64) gcv.Value {
+	lower, upper := gcv.IntervalBounds(value)
+	if math.IsNaN(lower) {
+		return value
+	}
+	return gcv.MakeInterval(math.Max(lower, rangeLower), math.Min(upper, rangeUpper))
+}
+
+// monotone returns the interval enclosing f over value, where f is monotone on the domain
+// [domainLower, domainUpper]. The parts of value outside the domain are ignored
+func monotone(value gcv.Value, f func(float64) float64, increasing bool, domainLower, domainUpper float64) gcv.Value {
+	lower, upper := gcv.IntervalBounds(value)
+	lower, upper = math.Max(lower, domainLower), math.Min(upper, domainUpper)
+	if math.IsNaN(lower) || math.IsNaN(upper) || lower > upper {
+		return nanInterval
+	}
+	if increasing {
+		return outward(f(lower), f(upper), functionULPs)
+	}
+	return outward(f(upper), f(lower), functionULPs)
+}
+
+// containsPeriodic returns true if [lower, upper] holds a point offset + k*period for some integer k.
+// A small margin is added so a point is never missed through rounding
+func containsPeriodic(lower, upper, offset, period float64) bool {
+	margin := 1e-15 * math.Max(1, math.Max(math.Abs(lower), math.Abs(upper)))
+	k := math.Ceil((lower - margin - offset) / period)
+	return offset+k*period <= upper+margin
+}
+
+func intervalAdd(valueA gcv.Value, valueB gcv.Value) gcv.Value {
+	lowerA, upperA := gcv.IntervalBounds(valueA)
+	lowerB, upperB := gcv.IntervalBounds(valueB)
+	return outward(lowerA+lowerB, upperA+upperB, arithmeticULPs)
+}
+
+func intervalSub(valueA gcv.Value, valueB gcv.Value) gcv.Value {
+	lowerA, upperA := gcv.IntervalBounds(valueA)
+	lowerB, upperB := gcv.IntervalBounds(valueB)
+	return outward(lowerA-upperB, upperA-lowerB, arithmeticULPs)
+}
+
+// product returns a*b, taking 0*Inf to be 0
+func product(a, b float64) float64 {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return a * b
+}
+
+func intervalMult(valueA gcv.Value, valueB gcv.Value) gcv.Value {
+	lowerA, upperA := gcv.IntervalBounds(valueA)
+	lowerB, upperB := gcv.IntervalBounds(valueB)
+	products := []float64{product(lowerA, lowerB), product(lowerA, upperB), product(upperA, lowerB), product(upperA, upperB)}
+	return outward(minOf(products...), maxOf(products...), arithmeticULPs)
+}
+
+func intervalDiv(valueA gcv.Value, valueB gcv.Value) gcv.Value {
+	lowerA, upperA := gcv.IntervalBounds(valueA)
+	lowerB, upperB := gcv.IntervalBounds(valueB)
+	if lowerB <= 0 && upperB >= 0 {
+		if lowerB == 0 && upperB == 0 || math.IsNaN(lowerA) {
+			return nanInterval
+		}
+		return gcv.MakeInterval(math.Inf(-1), math.Inf(1))
+	}
+	quotients := []float64{lowerA / lowerB, lowerA / upperB, upperA / lowerB, upperA / upperB}
+	return outward(minOf(quotients...), maxOf(quotients...), arithmeticULPs)
+}
+
+func intervalAbs(value gcv.Value) gcv.Value {
+	lower, upper := gcv.IntervalBounds(value)
+	switch {
+	case math.IsNaN(lower) || math.IsNaN(upper):
+		return nanInterval
+	case lower >= 0:
+		return gcv.MakeInterval(lower, upper)
+	case upper <= 0:
+		return gcv.MakeInterval(-upper, -lower)
+	}
+	return gcv.MakeInterval(0, math.Max(-lower, upper))
+}
+
+func intervalSin(value gcv.Value) gcv.Value {
+	return intervalSinusoid(value, math.Sin, math.Pi/2, -math.Pi/2)
+}
+
+func intervalCos(value gcv.Value) gcv.Value {
+	return intervalSinusoid(value, math.Cos, 0, math.Pi)
+}
+
+// intervalSinusoid encloses f, with maxima at maxOffset + 2kPi and minima at minOffset + 2kPi,
+// over value. Between the extrema f is monotone so the endpoints bound f unless an extremum is inside
+func intervalSinusoid(value gcv.Value, f func(float64) float64, maxOffset, minOffset float64) gcv.Value {
+	lower, upper := gcv.IntervalBounds(value)
+	if math.IsNaN(lower) || math.IsNaN(upper) {
+		return nanInterval
+	}
+	if math.IsInf(lower, 0) || math.IsInf(upper, 0) || upper-lower >= 2*math.Pi {
+		return gcv.MakeInterval(-1, 1)
+	}
+	a, b := f(lower), f(upper)
+	resultLower, resultUpper := down(math.Min(a, b), functionULPs), up(math.Max(a, b), functionULPs)
+	if containsPeriodic(lower, upper, maxOffset, 2*math.Pi) {
+		resultUpper = 1
+	}
+	if containsPeriodic(lower, upper, minOffset, 2*math.Pi) {
+		resultLower = -1
+	}
+	return clamp(gcv.MakeInterval(resultLower, resultUpper), -1, 1)
+}
+
+// intervalPeriodicMonotone encloses f, monotone between poles at poleOffset + kPi, over value.
+// If value holds a pole the whole real line is returned
+func intervalPeriodicMonotone(value gcv.Value, f func(float64) float64, increasing bool, poleOffset float64) gcv.Value {
+	lower, upper := gcv.IntervalBounds(value)
+	if math.IsNaN(lower) || math.IsNaN(upper) {
+		return nanInterval
+	}
+	if math.IsInf(lower, 0) || math.IsInf(upper, 0) || upper-lower >= math.Pi ||
+		containsPeriodic(lower, upper, poleOffset, math.Pi) {
+		return gcv.MakeInterval(math.Inf(-1), math.Inf(1))
+	}
+	return monotone(value, f, increasing, lower, upper)
+}
+
+func intervalTan(value gcv.Value) gcv.Value {
+	return intervalPeriodicMonotone(value, math.Tan, true, math.Pi/2)
+}
+
+func intervalCot(value gcv.Value) gcv.Value {
+	return intervalPeriodicMonotone(value, func(x float64) float64 { return 1 / math.Tan(x) }, false, 0)
+}
+
+func intervalCosh(value gcv.Value) gcv.Value {
+	lower, upper := gcv.IntervalBounds(intervalAbs(value))
+	if math.IsNaN(lower) {
+		return nanInterval
+	}
+	return clamp(outward(math.Cosh(lower), math.Cosh(upper), functionULPs), 1, math.Inf(1))
+}
+
+func intervalPow(valueA gcv.Value, valueB gcv.Value) gcv.Value {
+	lowerB, upperB := gcv.IntervalBounds(valueB)
+	if lowerB != upperB || lowerB != math.Trunc(lowerB) || math.IsInf(lowerB, 0) {
+		return intervalExp(intervalMult(valueB, intervalLog(valueA)))
+	}
+
+	n := lowerB
+	switch {
+	case n == 0:
+		return gcv.MakeInterval(1, 1)
+	case n < 0:
+		return intervalDiv(gcv.MakeValue(1), intervalPow(valueA, gcv.MakeValue(-n)))
+	case math.Mod(n, 2) == 0:
+		valueA = intervalAbs(valueA)
+	}
+	pow := func(x float64) float64 { return math.Pow(x, n) }
+	return monotone(valueA, pow, true, math.Inf(-1), math.Inf(1))
+}
+
+func intervalExp(value gcv.Value) gcv.Value {
+	return clamp(monotone(value, math.Exp, true, math.Inf(-1), math.Inf(1)), 0, math.Inf(1))
+}
+
+func intervalLog(value gcv.Value) gcv.Value {
+	return monotone(value, math.Log, true, 0, math.Inf(1))
+}
+
+func intervalMod(valueA gcv.Value, valueB gcv.Value) gcv.Value {
+	lowerA, upperA := gcv.IntervalBounds(valueA)
+	lowerB, upperB := gcv.IntervalBounds(valueB)
+	if math.IsNaN(lowerA) || math.IsNaN(lowerB) || lowerB <= 0 && upperB >= 0 {
+		return nanInterval
+	}
+	// math.Mod is exact, and x - k*b is increasing in x as long as valueA does not wrap past a multiple of b
+	if lowerB == upperB && upperA-lowerA < math.Abs(lowerB) && (lowerA >= 0 || upperA <= 0) {
+		modLower, modUpper := math.Mod(lowerA, lowerB), math.Mod(upperA, upperB)
+		if modLower <= modUpper {
+			return gcv.MakeInterval(modLower, modUpper)
+		}
+	}
+	// the result takes the sign of valueA and is smaller in size than valueB
+	bound := math.Max(math.Abs(lowerB), math.Abs(upperB))
+	switch {
+	case lowerA >= 0:
+		return gcv.MakeInterval(0, bound)
+	case upperA <= 0:
+		return gcv.MakeInterval(-bound, 0)
+	}
+	return gcv.MakeInterval(-bound, bound)
+}
+
+// endpointwise returns the interval of f applied to both endpoints, for exact nondecreasing f
+func endpointwise(value gcv.Value, f func(float64) float64) gcv.Value {
+	lower, upper := gcv.IntervalBounds(value)
+	return gcv.MakeInterval(f(lower), f(upper))
+}
+
+func minOf(values ...float64) float64 {
+	min := values[0]
+	for _, value := range values[1:] {
+		min = math.Min(min, value)
+	}
+	return min
+}
+
+func maxOf(values ...float64) float64 {
+	max := values[0]
+	for _, value := range values[1:] {
+		max = math.Max(max, value)
+	}
+	return max
+}
@@ -0,0 +1,141 @@
+package ops
+
+import (
+	"math"
+	"testing"
+
+	gcv "github.com/NumberXNumbers/types/gc/values"
+)
+
+// encloses returns true if the interval holds every point of [lower, upper]
+func encloses(value gcv.Value, lower, upper float64) bool {
+	valueLower, valueUpper := gcv.IntervalBounds(value)
+	return valueLower <= lower && upper <= valueUpper
+}
+
+// tight returns true if the interval is within 1e-12 of [lower, upper]
+func tight(value gcv.Value, lower, upper float64) bool {
+	valueLower, valueUpper := gcv.IntervalBounds(value)
+	return encloses(value, lower, upper) && (lower == valueLower || lower-valueLower < 1e-12) &&
+		(upper == valueUpper || valueUpper-upper < 1e-12)
+}
+
+func TestIntervalArithmetic(t *testing.T) {
+	testCases := []struct {
+		name         string
+		result       gcv.Value
+		lower, upper float64
+	}{
+		{"Add", Add(gcv.MakeInterval(1, 2), gcv.MakeInterval(-3, 5)), -2, 7},
+		{"AddReal", Add(gcv.MakeInterval(1, 2), gcv.MakeValue(1)), 2, 3},
+		{"Sub", Sub(gcv.MakeInterval(1, 2), gcv.MakeInterval(-3, 5)), -4, 5},
+		{"Mult", Mult(gcv.MakeInterval(-1, 2), gcv.MakeInterval(-3, 5)), -6, 10},
+		{"Div", Div(gcv.MakeInterval(1, 2), gcv.MakeInterval(4, 8)), 0.125, 0.5},
+		{"DivZero", Div(gcv.MakeInterval(1, 2), gcv.MakeInterval(-1, 1)), math.Inf(-1), math.Inf(1)},
+		{"Abs", Abs(gcv.MakeInterval(-3, 2)), 0, 3},
+		{"Inv", Inv(gcv.MakeInterval(2, 4)), 0.25, 0.5},
+		{"Sqrt", Sqrt(gcv.MakeInterval(4, 9)), 2, 3},
+		{"Exp", Exp(gcv.MakeInterval(0, 1)), 1, math.E},
+		{"Log", Log(gcv.MakeInterval(1, math.E)), 0, 1},
+		{"Sin", Sin(gcv.MakeInterval(0, math.Pi)), 0, 1},
+		{"SinMinimum", Sin(gcv.MakeInterval(4, 5)), -1, math.Sin(4)},
+		{"SinWide", Sin(gcv.MakeInterval(0, 7)), -1, 1},
+		{"Cos", Cos(gcv.MakeInterval(-1, 1)), math.Cos(1), 1},
+		{"CosMonotone", Cos(gcv.MakeInterval(1, 2)), math.Cos(2), math.Cos(1)},
+		{"Tan", Tan(gcv.MakeInterval(0, 1)), 0, math.Tan(1)},
+		{"TanPole", Tan(gcv.MakeInterval(1, 2)), math.Inf(-1), math.Inf(1)},
+		{"Cosh", Cosh(gcv.MakeInterval(-1, 2)), 1, math.Cosh(2)},
+		{"PowEven", Pow(gcv.MakeInterval(-2, 1), gcv.MakeValue(2)), 0, 4},
+		{"PowOdd", Pow(gcv.MakeInterval(-2, 1), gcv.MakeValue(3)), -8, 1},
+		{"PowNegative", Pow(gcv.MakeInterval(1, 2), gcv.MakeValue(-1)), 0.5, 1},
+		{"PowInterval", Pow(gcv.MakeInterval(1, 2), gcv.MakeInterval(2, 3)), 1, 8},
+		{"Mod", MustMod(gcv.MakeInterval(5, 6), gcv.MakeValue(4)), 1, 2},
+		{"ModWrap", MustMod(gcv.MakeInterval(3, 5), gcv.MakeValue(4)), 0, 4},
+		{"Max", MustMax(gcv.MakeInterval(1, 3), gcv.MakeInterval(2, 4)), 2, 4},
+		{"Floor", MustFloor(gcv.MakeInterval(1.5, 3.5)), 1, 3},
+	}
+
+	for _, testCase := range testCases {
+		if testCase.result.Type() != gcv.Interval {
+			t.Errorf("%s: Expected %v, received %v", testCase.name, gcv.Interval, testCase.result.Type())
+		}
+		if !tight(testCase.result, testCase.lower, testCase.upper) {
+			t.Errorf("%s: Expected %v, received %v", testCase.name, []float64{testCase.lower, testCase.upper}, testCase.result)
+		}
+	}
+}
+
+func TestIntervalOutwardRounding(t *testing.T) {
+	// the float64 sum of 0.1 and 0.2 is rounded, so the interval must reach past it on both sides
+	a, b := 0.1, 0.2
+	result := Add(gcv.MakeInterval(a, a), gcv.MakeInterval(b, b))
+	lower, upper := gcv.IntervalBounds(result)
+	if lower >= a+b || upper <= a+b {
+		t.Errorf("Expected %v to hold %v", result, a+b)
+	}
+
+	// every sample of sin over the interval must be inside the result
+	for x := 2.0; x <= 8; x += 0.01 {
+		if !encloses(Sin(gcv.MakeInterval(2, 8)), math.Sin(x), math.Sin(x)) {
+			t.Errorf("Expected Sin([2, 8]) to hold %v", math.Sin(x))
+		}
+	}
+
+	if result := Sqrt(gcv.MakeInterval(-2, -1)); !math.IsNaN(result.Real()) {
+		t.Errorf("Expected %v, received %v", math.NaN(), result)
+	}
+
+	if _, err := Slerp(gcv.MakeInterval(1, 2), gcv.MakeValue(1), 0.5); err == nil {
+		t.Errorf("Expected error")
+	}
+}
+
+func TestIntervalNewton(t *testing.T) {
+	f := func(x gcv.Value) gcv.Value { return Sub(Mult(x, x), gcv.MakeValue(2)) }
+	df := func(x gcv.Value) gcv.Value { return Mult(gcv.MakeValue(2), x) }
+
+	roots, err := IntervalNewton(f, df, gcv.MakeInterval(-3, 3), 1e-10)
+	if err != nil {
+		t.Fatalf("Expected no error, received %v", err)
+	}
+	if len(roots) != 2 {
+		t.Fatalf("Expected %v, received %v", 2, roots)
+	}
+	if !encloses(roots[0], -math.Sqrt2, -math.Sqrt2) || !encloses(roots[1], math.Sqrt2, math.Sqrt2) {
+		t.Errorf("Expected enclosures of %v, received %v", []float64{-math.Sqrt2, math.Sqrt2}, roots)
+	}
+	for _, root := range roots {
+		if width := root.(gcv.IntervalValue).Width(); width > 1e-10 {
+			t.Errorf("Expected width at most %v, received %v", 1e-10, width)
+		}
+	}
+
+	sinRoots := MustIntervalNewton(Sin, Cos, gcv.MakeInterval(2, 10), 1e-9)
+	if len(sinRoots) != 3 {
+		t.Fatalf("Expected %v, received %v", 3, sinRoots)
+	}
+	for k, root := range sinRoots {
+		if !encloses(root, float64(k+1)*math.Pi, float64(k+1)*math.Pi) {
+			t.Errorf("Expected enclosure of %v, received %v", float64(k+1)*math.Pi, root)
+		}
+	}
+
+	if roots := MustIntervalNewton(f, df, gcv.MakeInterval(2, 3), 1e-10); len(roots) != 0 {
+		t.Errorf("Expected no roots, received %v", roots)
+	}
+
+	if _, err := IntervalNewton(f, df, gcv.MakeValue(1i), 1e-10); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := IntervalNewton(f, df, gcv.MakeInterval(0, 1), 0); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic")
+		}
+	}()
+	MustIntervalNewton(f, df, gcv.MakeInterval(0, math.Inf(1)), 1e-10)
+}
@@ -0,0 +1,221 @@
+package ops
+
+import (
+	"fmt"
+
+	gcv "github.com/NumberXNumbers/types/gc/values"
+)
+
+// isQuantity returns true if any of the values are of type Quantity
+func isQuantity(values ...gcv.Value) bool {
+	for _, value := range values {
+		if value.Type() == gcv.Quantity {
+			return true
+		}
+	}
+	return false
+}
+
+// mustQuantity returns value, or panics with err like the Must functions. It is used by the
+// operations, such as Add and Sin, that can not return an error. Use AddQuantity, SubQuantity,
+// PowQuantity and ApplyDimensionless to get the error instead
+func mustQuantity(value gcv.Value, err error) gcv.Value {
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// inUnit returns the magnitude of value measured in unit
+func inUnit(value gcv.Value, unit gcv.Unit) (gcv.Value, error) {
+	magnitude, from := gcv.QuantityParts(value)
+	scale, err := gcv.ConvertScale(from, unit)
+	if err != nil {
+		return nil, err
+	}
+	if scale == 1 {
+		return magnitude, nil
+	}
+	return Mult(magnitude, gcv.MakeValue(scale)), nil
+}
+
+// makeQuantity returns magnitude in unit. Dimensionless results are returned as plain Values
+func makeQuantity(magnitude gcv.Value, unit gcv.Unit) gcv.Value {
+	if unit.Dimension().IsDimensionless() {
+		if unit.Scale() == 1 {
+			return magnitude
+		}
+		return Mult(magnitude, gcv.MakeValue(unit.Scale()))
+	}
+	return gcv.MakeQuantityAlt(magnitude, unit)
+}
+
+// sameUnit returns the magnitudes of valueA and valueB both in the unit of valueA.
+// Returns error if the Values are not of the same Dimension
+func sameUnit(valueA gcv.Value, valueB gcv.Value, operation string) (gcv.Value, gcv.Value, gcv.Unit, error) {
+	magnitudeA, unit := gcv.QuantityParts(valueA)
+	magnitudeB, err := inUnit(valueB, unit)
+	if err != nil {
+		_, unitB := gcv.QuantityParts(valueB)
+		return nil, nil, nil, fmt.Errorf("Can not %s [%s] and [%s]", operation, unit.Dimension(), unitB.Dimension())
+	}
+	return magnitudeA, magnitudeB, unit, nil
+}
+
+// keepUnit returns f applied to the magnitude of value, in the unit of value
+func keepUnit(value gcv.Value, f func(gcv.Value) gcv.Value) gcv.Value {
+	magnitude, unit := gcv.QuantityParts(value)
+	return makeQuantity(f(magnitude), unit)
+}
+
+// Convert returns the Quantity value in the unit described by unit, see gcv.ParseUnit.
+// Returns error if unit can not be parsed or is not of the same Dimension as value
+func Convert(value gcv.Value, unit string) (gcv.Value, error) {
+	u, err := gcv.ParseUnit(unit)
+	if err != nil {
+		return nil, err
+	}
+	return ConvertAlt(value, u)
+}
+
+// MustConvert is the same as Convert but will panic
+func MustConvert(value gcv.Value, unit string) gcv.Value {
+	result, err := Convert(value, unit)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// ConvertAlt returns the Quantity value in gcv Unit unit.
+// Returns error if unit is not of the same Dimension as value
+func ConvertAlt(value gcv.Value, unit gcv.Unit) (gcv.Value, error) {
+	magnitude, err := inUnit(value, unit)
+	if err != nil {
+		return nil, err
+	}
+	return gcv.MakeQuantityAlt(magnitude, unit), nil
+}
+
+// AddQuantity adds two gcv Values, giving the result in the unit of valueA.
+// Returns error if the Values are not of the same Dimension
+func AddQuantity(valueA gcv.Value, valueB gcv.Value) (gcv.Value, error) {
+	magnitudeA, magnitudeB, unit, err := sameUnit(valueA, valueB, "add")
+	if err != nil {
+		return nil, err
+	}
+	return makeQuantity(Add(magnitudeA, magnitudeB), unit), nil
+}
+
+// MustAddQuantity is the same as AddQuantity but will panic
+func MustAddQuantity(valueA gcv.Value, valueB gcv.Value) gcv.Value {
+	value, err := AddQuantity(valueA, valueB)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// SubQuantity subtracts two gcv Values, giving the result in the unit of valueA.
+// Returns error if the Values are not of the same Dimension
+func SubQuantity(valueA gcv.Value, valueB gcv.Value) (gcv.Value, error) {
+	magnitudeA, magnitudeB, unit, err := sameUnit(valueA, valueB, "subtract")
+	if err != nil {
+		return nil, err
+	}
+	return makeQuantity(Sub(magnitudeA, magnitudeB), unit), nil
+}
+
+// MustSubQuantity is the same as SubQuantity but will panic
+func MustSubQuantity(valueA gcv.Value, valueB gcv.Value) gcv.Value {
+	value, err := SubQuantity(valueA, valueB)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+func quantityMult(valueA gcv.Value, valueB gcv.Value) gcv.Value {
+	magnitudeA, unitA := gcv.QuantityParts(valueA)
+	magnitudeB, unitB := gcv.QuantityParts(valueB)
+	return makeQuantity(Mult(magnitudeA, magnitudeB), unitA.Mult(unitB))
+}
+
+func quantityDiv(valueA gcv.Value, valueB gcv.Value) gcv.Value {
+	magnitudeA, unitA := gcv.QuantityParts(valueA)
+	magnitudeB, unitB := gcv.QuantityParts(valueB)
+	return makeQuantity(Div(magnitudeA, magnitudeB), unitA.Div(unitB))
+}
+
+// PowQuantity returns gcv Value valueA raised to the power of gcv Value valueB.
+// Returns error if valueB is not a dimensionless Real Value, or valueA has a Dimension
+// that can not be raised to valueB, such as the square root of metres
+func PowQuantity(valueA gcv.Value, valueB gcv.Value) (gcv.Value, error) {
+	exponent, err := inUnit(valueB, gcv.Dimensionless())
+	if err != nil {
+		_, unitB := gcv.QuantityParts(valueB)
+		return nil, fmt.Errorf("Exponent must be dimensionless, not [%s]", unitB.Dimension())
+	}
+	magnitude, unit := gcv.QuantityParts(valueA)
+	if unit.Dimension().IsDimensionless() {
+		magnitude, _ = inUnit(valueA, gcv.Dimensionless())
+		return Pow(magnitude, exponent), nil
+	}
+	if exponent.Type() != gcv.Real {
+		return nil, fmt.Errorf("Quantity of [%s] can only be raised to a Real power", unit.Dimension())
+	}
+	powUnit, err := unit.Pow(exponent.Real())
+	if err != nil {
+		return nil, fmt.Errorf("Can not raise [%s] to the power %v", unit.Dimension(), exponent.Real())
+	}
+	return makeQuantity(Pow(magnitude, exponent), powUnit), nil
+}
+
+// MustPowQuantity is the same as PowQuantity but will panic
+func MustPowQuantity(valueA gcv.Value, valueB gcv.Value) gcv.Value {
+	value, err := PowQuantity(valueA, valueB)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// ApplyDimensionless returns f, such as Sin or Exp, of a dimensionless gcv Value.
+// A dimensionless Quantity, such as radians or m/km, is converted to a plain number first.
+// Sin and the other functions of plain numbers panic for a Value with a Dimension.
+// Returns error if value has a Dimension
+func ApplyDimensionless(f func(gcv.Value) gcv.Value, value gcv.Value) (gcv.Value, error) {
+	magnitude, err := inUnit(value, gcv.Dimensionless())
+	if err != nil {
+		_, unit := gcv.QuantityParts(value)
+		return nil, fmt.Errorf("Value of [%s] is not dimensionless", unit.Dimension())
+	}
+	return f(magnitude), nil
+}
+
+// MustApplyDimensionless is the same as ApplyDimensionless but will panic
+func MustApplyDimensionless(f func(gcv.Value) gcv.Value, value gcv.Value) gcv.Value {
+	result, err := ApplyDimensionless(f, value)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// dimensionless returns f of value, or panics if value has a Dimension
+func dimensionless(f func(gcv.Value) gcv.Value, value gcv.Value) gcv.Value {
+	return mustQuantity(ApplyDimensionless(f, value))
+}
+
+// quantityCompare applies f, such as Mod or Max, to the magnitudes of valueA and valueB in the unit of valueA
+func quantityCompare(valueA gcv.Value, valueB gcv.Value, operation string, f func(gcv.Value, gcv.Value) (gcv.Value, error)) (gcv.Value, error) {
+	magnitudeA, magnitudeB, unit, err := sameUnit(valueA, valueB, operation)
+	if err != nil {
+		return nil, err
+	}
+	magnitude, err := f(magnitudeA, magnitudeB)
+	if err != nil {
+		return nil, err
+	}
+	return makeQuantity(magnitude, unit), nil
+}
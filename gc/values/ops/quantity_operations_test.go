@@ -0,0 +1,127 @@
+package ops
+
+import (
+	"math"
+	"testing"
+
+	gcv "github.com/NumberXNumbers/types/gc/values"
+)
+
+// checkQuantity fails unless value is magnitude in unit
+func checkQuantity(t *testing.T, name string, value gcv.Value, magnitude float64, unit string) {
+	valueMagnitude, valueUnit := gcv.QuantityParts(value)
+	if math.Abs(valueMagnitude.Real()-magnitude) > 1e-12*math.Max(1, math.Abs(magnitude)) || valueUnit.String() != unit {
+		t.Errorf("%s: Expected %v %s, received %v", name, magnitude, unit, value)
+	}
+}
+
+func TestQuantityOperations(t *testing.T) {
+	distance := gcv.MustMakeQuantity(5, "km")
+	time := gcv.MustMakeQuantity(30, "min")
+
+	checkQuantity(t, "Add", Add(distance, gcv.MustMakeQuantity(300, "m")), 5.3, "km")
+	checkQuantity(t, "Sub", Sub(gcv.MustMakeQuantity(300, "m"), distance), -4700, "m")
+	checkQuantity(t, "Mult", Mult(distance, gcv.MakeValue(2)), 10, "km")
+	checkQuantity(t, "Div", Div(distance, time), 5.0/30, "km/min")
+	checkQuantity(t, "DivDimensionless", Div(distance, gcv.MustMakeQuantity(500, "m")), 10, "")
+	checkQuantity(t, "Pow", Pow(distance, gcv.MakeValue(2)), 25, "km^2")
+	checkQuantity(t, "Sqrt", Sqrt(gcv.MustMakeQuantity(16, "m^2")), 4, "(m^2)^0.5")
+	checkQuantity(t, "Abs", Abs(gcv.MustMakeQuantity(-3, "s")), 3, "s")
+	checkQuantity(t, "Max", MustMax(distance, gcv.MustMakeQuantity(6000, "m")), 6, "km")
+	checkQuantity(t, "Min", MustMin(distance, gcv.MustMakeQuantity(6000, "m")), 5, "km")
+	checkQuantity(t, "Mod", MustMod(gcv.MustMakeQuantity(130, "min"), gcv.MustMakeQuantity(1, "h")), 10, "min")
+	checkQuantity(t, "Floor", MustFloor(gcv.MustMakeQuantity(2.5, "m")), 2, "m")
+	checkQuantity(t, "Sin", Sin(gcv.MustMakeQuantity(math.Pi/2, "rad")), 1, "")
+	checkQuantity(t, "Exp", Exp(Div(gcv.MustMakeQuantity(1, "km"), gcv.MustMakeQuantity(1000, "m"))), math.E, "")
+
+	speed := MustConvert(Div(distance, time), "m/s")
+	checkQuantity(t, "Convert", speed, 5000.0/1800, "m/s")
+
+	if _, err := Convert(distance, "s"); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := Convert(distance, "parsec"); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	// interval and uncertain magnitudes keep their type through the unit conversion
+	measured := gcv.MakeQuantityAlt(gcv.MakeUncertain(2, 0.1), gcv.MustParseUnit("km"))
+	inMetres := MustConvert(measured, "m")
+	if mean, stdDev := gcv.UncertainParts(inMetres.(gcv.QuantityValue).Magnitude()); mean != 2000 || math.Abs(stdDev-100) > 1e-12 {
+		t.Errorf("Expected %v, received %v", "(2000±100) m", inMetres)
+	}
+}
+
+func TestQuantityErrors(t *testing.T) {
+	distance := gcv.MustMakeQuantity(5, "km")
+	time := gcv.MustMakeQuantity(30, "min")
+
+	if _, err := AddQuantity(distance, time); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := SubQuantity(distance, gcv.MakeValue(1)); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := ApplyDimensionless(Sin, distance); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := PowQuantity(distance, time); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := PowQuantity(distance, gcv.MakeValue(0.5)); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := Max(distance, time); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := Erf(distance); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	value := MustApplyDimensionless(Cos, gcv.MakeValue(0))
+	if value.Real() != 1 {
+		t.Errorf("Expected %v, received %v", 1, value)
+	}
+
+	if sum := MustAddQuantity(gcv.MakeValue(1), gcv.MustMakeQuantity(1, "m/km")); sum.Real() != 1.001 {
+		t.Errorf("Expected %v, received %v", 1.001, sum)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic")
+		}
+	}()
+	MustAddQuantity(distance, time)
+}
+
+func TestQuantityPanics(t *testing.T) {
+	distance := gcv.MustMakeQuantity(5, "km")
+	time := gcv.MustMakeQuantity(30, "min")
+
+	panics := map[string]func(){
+		"Add":     func() { Add(distance, time) },
+		"Sub":     func() { Sub(distance, time) },
+		"Sqrt":    func() { Sqrt(gcv.MustMakeQuantity(1, "m^3")) },
+		"Pow":     func() { Pow(distance, time) },
+		"Sin":     func() { Sin(distance) },
+		"LogBase": func() { LogBase(distance, gcv.MakeValue(2)) },
+	}
+	for name, f := range panics {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("Expected panic from %s", name)
+				}
+			}()
+			f()
+		}()
+	}
+}
@@ -0,0 +1,129 @@
+package ops
+
+import (
+	"errors"
+	"math"
+
+	gcv "github.com/NumberXNumbers/types/gc/values"
+)
+
+// slerpThreshold is the cosine of the angle above which Slerp falls back to a normalized lerp
+const slerpThreshold = 0.9995
+
+// isQuaternion returns true if any of the values are of type Quaternion
+func isQuaternion(values ...gcv.Value) bool {
+	for _, value := range values {
+		if value.Type() == gcv.Quaternion {
+			return true
+		}
+	}
+	return false
+}
+
+// quaternionAdd returns the part wise sum of a and scale*b
+func quaternionAdd(valueA gcv.Value, valueB gcv.Value, scale float64) gcv.Value {
+	wA, xA, yA, zA := gcv.QuaternionParts(valueA)
+	wB, xB, yB, zB := gcv.QuaternionParts(valueB)
+	return gcv.MakeQuaternion(wA+scale*wB, xA+scale*xB, yA+scale*yB, zA+scale*zB)
+}
+
+// quaternionMult returns the Hamilton product valueA*valueB
+func quaternionMult(valueA gcv.Value, valueB gcv.Value) gcv.Value {
+	wA, xA, yA, zA := gcv.QuaternionParts(valueA)
+	wB, xB, yB, zB := gcv.QuaternionParts(valueB)
+	return gcv.MakeQuaternion(
+		wA*wB-xA*xB-yA*yB-zA*zB,
+		wA*xB+xA*wB+yA*zB-zA*yB,
+		wA*yB-xA*zB+yA*wB+zA*xB,
+		wA*zB+xA*yB-yA*xB+zA*wB)
+}
+
+// quaternionAbs returns the norm of a quaternion
+func quaternionAbs(value gcv.Value) float64 {
+	w, x, y, z := gcv.QuaternionParts(value)
+	return math.Hypot(math.Hypot(w, x), math.Hypot(y, z))
+}
+
+// quaternionConj returns the conjugate w - xi - yj - zk
+func quaternionConj(value gcv.Value) gcv.Value {
+	w, x, y, z := gcv.QuaternionParts(value)
+	return gcv.MakeQuaternion(w, -x, -y, -z)
+}
+
+// quaternionInv returns the conjugate divided by the square of the norm
+func quaternionInv(value gcv.Value) gcv.Value {
+	w, x, y, z := gcv.QuaternionParts(value)
+	normSq := w*w + x*x + y*y + z*z
+	return gcv.MakeQuaternion(w/normSq, -x/normSq, -y/normSq, -z/normSq)
+}
+
+// quaternionFunc applies the complex function f to a quaternion.
+// Writing the quaternion as w + |v|u with u a unit pure quaternion, u*u = -1,
+// so the quaternion behaves like the complex number w + |v|i
+func quaternionFunc(value gcv.Value, f func(complex128) complex128) gcv.Value {
+	w, x, y, z := gcv.QuaternionParts(value)
+	norm := math.Hypot(x, math.Hypot(y, z))
+	result := f(complex(w, norm))
+	if norm == 0 {
+		return gcv.MakeValue(result)
+	}
+	scale := imag(result) / norm
+	return gcv.MakeQuaternion(real(result), x*scale, y*scale, z*scale)
+}
+
+// Inv returns the multiplicative inverse of a gcv Value
+func Inv(value gcv.Value) gcv.Value {
+	if isInterval(value) {
+		return intervalDiv(gcv.MakeValue(1), value)
+	}
+	if isQuaternion(value) {
+		return quaternionInv(value)
+	}
+	return Div(gcv.MakeValue(1), value)
+}
+
+// Slerp returns the spherical linear interpolation from gcv Value valueA to valueB at t,
+// where t is between 0 and 1. Both Values are normalized first and the shortest arc is taken.
+// if either Value is zero or an Interval an error is returned
+func Slerp(valueA gcv.Value, valueB gcv.Value, t float64) (gcv.Value, error) {
+	if isInterval(valueA, valueB) {
+		return nil, errors.New("Slerp is not supported for Interval Values")
+	}
+	normA, normB := quaternionAbs(valueA), quaternionAbs(valueB)
+	if normA == 0 || normB == 0 {
+		return nil, errors.New("Slerp is not supported for zero Values")
+	}
+	wA, xA, yA, zA := gcv.QuaternionParts(valueA)
+	wB, xB, yB, zB := gcv.QuaternionParts(valueB)
+	wA, xA, yA, zA = wA/normA, xA/normA, yA/normA, zA/normA
+	wB, xB, yB, zB = wB/normB, xB/normB, yB/normB, zB/normB
+
+	dot := wA*wB + xA*xB + yA*yB + zA*zB
+	if dot < 0 {
+		wB, xB, yB, zB = -wB, -xB, -yB, -zB
+		dot = -dot
+	}
+
+	scaleA, scaleB := 1-t, t
+	if dot < slerpThreshold {
+		theta := math.Acos(dot)
+		sinTheta := math.Sin(theta)
+		scaleA = math.Sin((1-t)*theta) / sinTheta
+		scaleB = math.Sin(t*theta) / sinTheta
+	}
+	w := scaleA*wA + scaleB*wB
+	x := scaleA*xA + scaleB*xB
+	y := scaleA*yA + scaleB*yB
+	z := scaleA*zA + scaleB*zB
+	norm := math.Hypot(math.Hypot(w, x), math.Hypot(y, z))
+	return gcv.MakeQuaternion(w/norm, x/norm, y/norm, z/norm), nil
+}
+
+// MustSlerp is the same as Slerp but will panic if either value is zero
+func MustSlerp(valueA gcv.Value, valueB gcv.Value, t float64) gcv.Value {
+	value, err := Slerp(valueA, valueB, t)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
@@ -0,0 +1,188 @@
+package ops
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+
+	gcv "github.com/NumberXNumbers/types/gc/values"
+)
+
+var (
+	testQuaternionI = gcv.MakeValue(1i)
+	testQuaternionJ = gcv.MakeQuaternion(0, 0, 1, 0)
+	testQuaternionK = gcv.MakeQuaternion(0, 0, 0, 1)
+	testQuaternionA = gcv.MakeQuaternion(1, 2, 3, 4)
+)
+
+func quaternionsClose(valueA gcv.Value, valueB gcv.Value) bool {
+	return quaternionAbs(Sub(valueA, valueB)) < 1e-12
+}
+
+func TestQuaternionArithmetic(t *testing.T) {
+	result = Add(testQuaternionA, testValueC)
+	solution = gcv.MakeQuaternion(2, 3, 3, 4)
+	if !reflect.DeepEqual(result, solution) {
+		t.Errorf("Expected %v, received %v", solution, result)
+	}
+
+	result = Sub(testQuaternionA, gcv.MakeQuaternion(0, 0, 3, 4))
+	solution = gcv.MakeValue(1 + 2i)
+	if !reflect.DeepEqual(result, solution) {
+		t.Errorf("Expected %v, received %v", solution, result)
+	}
+
+	result = Mult(testQuaternionI, testQuaternionJ)
+	solution = testQuaternionK
+	if !reflect.DeepEqual(result, solution) {
+		t.Errorf("Expected %v, received %v", solution, result)
+	}
+
+	result = Mult(testQuaternionJ, testQuaternionI)
+	solution = gcv.MakeQuaternion(0, 0, 0, -1)
+	if !reflect.DeepEqual(result, solution) {
+		t.Errorf("Expected %v, received %v", solution, result)
+	}
+
+	result = Mult(testQuaternionK, testQuaternionK)
+	solution = gcv.MakeValue(-1)
+	if !reflect.DeepEqual(result, solution) {
+		t.Errorf("Expected %v, received %v", solution, result)
+	}
+
+	result = Div(testQuaternionA, testQuaternionA)
+	solution = gcv.MakeValue(1)
+	if !quaternionsClose(result, solution) {
+		t.Errorf("Expected %v, received %v", solution, result)
+	}
+
+	result = Mult(Div(testQuaternionK, testQuaternionA), testQuaternionA)
+	solution = testQuaternionK
+	if !quaternionsClose(result, solution) {
+		t.Errorf("Expected %v, received %v", solution, result)
+	}
+}
+
+func TestQuaternionConjAbsInv(t *testing.T) {
+	result = Conj(testQuaternionA)
+	solution = gcv.MakeQuaternion(1, -2, -3, -4)
+	if !reflect.DeepEqual(result, solution) {
+		t.Errorf("Expected %v, received %v", solution, result)
+	}
+
+	result = Abs(testQuaternionA)
+	solution = gcv.MakeValue(math.Sqrt(30))
+	if !quaternionsClose(result, solution) {
+		t.Errorf("Expected %v, received %v", solution, result)
+	}
+
+	result = Mult(testQuaternionA, Inv(testQuaternionA))
+	solution = gcv.MakeValue(1)
+	if !quaternionsClose(result, solution) {
+		t.Errorf("Expected %v, received %v", solution, result)
+	}
+
+	result = Inv(testValueB)
+	solution = gcv.MakeValue(0.5)
+	if !reflect.DeepEqual(result, solution) {
+		t.Errorf("Expected %v, received %v", solution, result)
+	}
+}
+
+func TestQuaternionExpLog(t *testing.T) {
+	result = Exp(gcv.MakeQuaternion(0, 0, math.Pi/2, 0))
+	solution = testQuaternionJ
+	if !quaternionsClose(result, solution) {
+		t.Errorf("Expected %v, received %v", solution, result)
+	}
+
+	result = Exp(Log(testQuaternionA))
+	solution = testQuaternionA
+	if !quaternionsClose(result, solution) {
+		t.Errorf("Expected %v, received %v", solution, result)
+	}
+
+	result = Log(gcv.MakeQuaternion(-1, 0, 0, 1))
+	solution = gcv.MakeQuaternion(math.Log(math.Sqrt2), 0, 0, 3*math.Pi/4)
+	if !quaternionsClose(result, solution) {
+		t.Errorf("Expected %v, received %v", solution, result)
+	}
+
+	result = Exp(testValueB)
+	solution = gcv.MakeValue(math.Exp(2))
+	if !reflect.DeepEqual(result, solution) {
+		t.Errorf("Expected %v, received %v", solution, result)
+	}
+
+	result = Mult(Sqrt(testQuaternionA), Sqrt(testQuaternionA))
+	solution = testQuaternionA
+	if !quaternionsClose(result, solution) {
+		t.Errorf("Expected %v, received %v", solution, result)
+	}
+
+	result = Pow(testQuaternionA, testValueB)
+	solution = Mult(testQuaternionA, testQuaternionA)
+	if !quaternionsClose(result, solution) {
+		t.Errorf("Expected %v, received %v", solution, result)
+	}
+
+	if _, err := Max(testQuaternionA, testValueA); err == nil {
+		t.Fail()
+	}
+}
+
+func TestSlerp(t *testing.T) {
+	identity := gcv.MakeValue(1)
+	halfTurn := gcv.MakeQuaternion(0, 0, 0, 1)
+
+	result, err := Slerp(identity, halfTurn, 0.5)
+	solution = gcv.MakeQuaternion(math.Sqrt2/2, 0, 0, math.Sqrt2/2)
+	if err != nil || !quaternionsClose(result, solution) {
+		t.Errorf("Expected %v, received %v", solution, result)
+	}
+
+	result, err = Slerp(identity, halfTurn, 0)
+	if err != nil || !quaternionsClose(result, identity) {
+		t.Errorf("Expected %v, received %v", identity, result)
+	}
+
+	result, err = Slerp(gcv.MakeValue(2), gcv.MakeValue(-3), 1)
+	if err != nil || !quaternionsClose(result, identity) {
+		t.Errorf("Expected %v, received %v", identity, result)
+	}
+
+	result, err = Slerp(identity, gcv.MakeQuaternion(1, 0, 1e-6, 0), 0.5)
+	solution = gcv.MakeQuaternion(1, 0, 5e-7, 0)
+	if err != nil || !quaternionsClose(result, solution) {
+		t.Errorf("Expected %v, received %v", solution, result)
+	}
+
+	_, err = Slerp(gcv.Zero(), halfTurn, 0.5)
+	if err == nil {
+		t.Fail()
+	}
+}
+
+func TestMustSlerp(t *testing.T) {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Printf("Recovered from %v error\n", r)
+		}
+	}()
+
+	MustSlerp(testQuaternionA, gcv.Zero(), 0.5)
+	t.Errorf("Expected panic from MustSlerp")
+}
+
+func TestQuaternionRealOnly(t *testing.T) {
+	_, err := Floor(testQuaternionA)
+	if err == nil || err.Error() != "Floor is only supported for Real numbers" {
+		t.Errorf("Expected Real only error, received %v", err)
+	}
+
+	_, err = Max(testQuaternionA, gcv.MakeValue(1))
+	if err == nil || err.Error() != "Max is only supported for Real numbers" {
+		t.Errorf("Expected Real only error, received %v", err)
+	}
+}
@@ -0,0 +1,91 @@
+package ops
+
+import (
+	"errors"
+	"math"
+
+	gcv "github.com/NumberXNumbers/types/gc/values"
+)
+
+// isUncertain returns true if any of the values are of type Uncertain
+func isUncertain(values ...gcv.Value) bool {
+	for _, value := range values {
+		if value.Type() == gcv.Uncertain {
+			return true
+		}
+	}
+	return false
+}
+
+// uncertainFunc returns f of value with the error propagated through the derivative df
+func uncertainFunc(value gcv.Value, f, df func(float64) float64) gcv.Value {
+	x, _ := gcv.UncertainParts(value)
+	return gcv.Propagate(f(x), []gcv.Value{value}, []float64{df(x)})
+}
+
+// uncertainFunc2 returns f of valueA and valueB with the error propagated through the
+// partial derivatives dfa and dfb
+func uncertainFunc2(valueA gcv.Value, valueB gcv.Value, f, dfa, dfb func(a, b float64) float64) gcv.Value {
+	a, _ := gcv.UncertainParts(valueA)
+	b, _ := gcv.UncertainParts(valueB)
+	return gcv.Propagate(f(a, b), []gcv.Value{valueA, valueB}, []float64{dfa(a, b), dfb(a, b)})
+}
+
+func uncertainAdd(valueA gcv.Value, valueB gcv.Value, scale float64) gcv.Value {
+	return uncertainFunc2(valueA, valueB,
+		func(a, b float64) float64 { return a + scale*b },
+		func(a, b float64) float64 { return 1 },
+		func(a, b float64) float64 { return scale })
+}
+
+func uncertainMult(valueA gcv.Value, valueB gcv.Value) gcv.Value {
+	return uncertainFunc2(valueA, valueB,
+		func(a, b float64) float64 { return a * b },
+		func(a, b float64) float64 { return b },
+		func(a, b float64) float64 { return a })
+}
+
+func uncertainDiv(valueA gcv.Value, valueB gcv.Value) gcv.Value {
+	return uncertainFunc2(valueA, valueB,
+		func(a, b float64) float64 { return a / b },
+		func(a, b float64) float64 { return 1 / b },
+		func(a, b float64) float64 { return -a / (b * b) })
+}
+
+func uncertainPow(valueA gcv.Value, valueB gcv.Value) gcv.Value {
+	return uncertainFunc2(valueA, valueB, math.Pow,
+		func(a, b float64) float64 {
+			if b == 0 {
+				return 0
+			}
+			return b * math.Pow(a, b-1)
+		},
+		func(a, b float64) float64 { return math.Pow(a, b) * math.Log(a) })
+}
+
+func uncertainLogBase(valueA gcv.Value, valueB gcv.Value) gcv.Value {
+	return uncertainFunc2(valueA, valueB,
+		func(a, b float64) float64 { return math.Log(a) / math.Log(b) },
+		func(a, b float64) float64 { return 1 / (a * math.Log(b)) },
+		func(a, b float64) float64 { return -math.Log(a) / (b * math.Log(b) * math.Log(b)) })
+}
+
+func uncertainMod(valueA gcv.Value, valueB gcv.Value) gcv.Value {
+	return uncertainFunc2(valueA, valueB, math.Mod,
+		func(a, b float64) float64 { return 1 },
+		func(a, b float64) float64 { return -math.Trunc(a / b) })
+}
+
+// uncertainSelect returns valueA if chooseA is true and valueB if not, as an Uncertain Value.
+// Returns error if either Value is not Real or Uncertain
+func uncertainSelect(valueA gcv.Value, valueB gcv.Value, chooseA bool) (gcv.Value, error) {
+	meanA, _ := gcv.UncertainParts(valueA)
+	meanB, _ := gcv.UncertainParts(valueB)
+	if math.IsNaN(meanA) || math.IsNaN(meanB) {
+		return nil, errors.New("Only Real Values can be compared with Uncertain Values")
+	}
+	if chooseA {
+		return gcv.Propagate(meanA, []gcv.Value{valueA}, []float64{1}), nil
+	}
+	return gcv.Propagate(meanB, []gcv.Value{valueB}, []float64{1}), nil
+}
@@ -0,0 +1,137 @@
+package ops
+
+import (
+	"math"
+	"testing"
+
+	gcv "github.com/NumberXNumbers/types/gc/values"
+)
+
+// checkPropagation compares the standard deviation of result with the linear propagation
+// sqrt(sum (df/dx_i * sigma_i)^2) computed from central differences of f
+func checkPropagation(t *testing.T, name string, f func(values ...gcv.Value) gcv.Value, values ...gcv.Value) {
+	result := f(values...)
+	if result.Type() != gcv.Uncertain {
+		t.Errorf("%s: Expected %v, received %v", name, gcv.Uncertain, result.Type())
+		return
+	}
+
+	means := make([]gcv.Value, len(values))
+	for i, value := range values {
+		means[i] = gcv.MakeValue(value.Real())
+	}
+	if mean := f(means...).Real(); math.Abs(result.Real()-mean) > 1e-12*math.Max(1, math.Abs(mean)) {
+		t.Errorf("%s: Expected mean %v, received %v", name, mean, result.Real())
+	}
+
+	variance := 0.0
+	for i, value := range values {
+		_, stdDev := gcv.UncertainParts(value)
+		h := 1e-6 * math.Max(1, math.Abs(value.Real()))
+		plus := append([]gcv.Value{}, means...)
+		minus := append([]gcv.Value{}, means...)
+		plus[i] = gcv.MakeValue(value.Real() + h)
+		minus[i] = gcv.MakeValue(value.Real() - h)
+		derivative := (f(plus...).Real() - f(minus...).Real()) / (2 * h)
+		variance += derivative * derivative * stdDev * stdDev
+	}
+	if _, stdDev := gcv.UncertainParts(result); math.Abs(stdDev-math.Sqrt(variance)) > 1e-6*math.Max(1, stdDev) {
+		t.Errorf("%s: Expected standard deviation %v, received %v", name, math.Sqrt(variance), stdDev)
+	}
+}
+
+func unary(f func(gcv.Value) gcv.Value) func(values ...gcv.Value) gcv.Value {
+	return func(values ...gcv.Value) gcv.Value { return f(values[0]) }
+}
+
+func binary(f func(gcv.Value, gcv.Value) gcv.Value) func(values ...gcv.Value) gcv.Value {
+	return func(values ...gcv.Value) gcv.Value { return f(values[0], values[1]) }
+}
+
+func TestUncertainOperations(t *testing.T) {
+	x := gcv.MakeUncertain(0.5, 0.01)
+	y := gcv.MakeUncertain(2, 0.05)
+	large := gcv.MakeUncertain(3, 0.1)
+
+	checkPropagation(t, "Add", binary(Add), x, y)
+	checkPropagation(t, "Sub", binary(Sub), x, y)
+	checkPropagation(t, "Mult", binary(Mult), x, y)
+	checkPropagation(t, "Div", binary(Div), x, y)
+	checkPropagation(t, "Pow", binary(Pow), y, x)
+	checkPropagation(t, "PowReal", binary(Pow), y, gcv.MakeValue(3))
+	checkPropagation(t, "LogBase", binary(LogBase), y, large)
+	checkPropagation(t, "Mod", binary(MustMod), large, y)
+	checkPropagation(t, "Sqrt", unary(Sqrt), y)
+	checkPropagation(t, "Abs", unary(Abs), gcv.MakeUncertain(-2, 0.1))
+	checkPropagation(t, "Sin", unary(Sin), x)
+	checkPropagation(t, "Cos", unary(Cos), x)
+	checkPropagation(t, "Tan", unary(Tan), x)
+	checkPropagation(t, "Cot", unary(Cot), x)
+	checkPropagation(t, "Asin", unary(Asin), x)
+	checkPropagation(t, "Acos", unary(Acos), x)
+	checkPropagation(t, "Atan", unary(Atan), x)
+	checkPropagation(t, "Sinh", unary(Sinh), x)
+	checkPropagation(t, "Cosh", unary(Cosh), x)
+	checkPropagation(t, "Tanh", unary(Tanh), x)
+	checkPropagation(t, "Asinh", unary(Asinh), x)
+	checkPropagation(t, "Acosh", unary(Acosh), y)
+	checkPropagation(t, "Atanh", unary(Atanh), x)
+	checkPropagation(t, "Exp", unary(Exp), x)
+	checkPropagation(t, "Log", unary(Log), y)
+	checkPropagation(t, "Log10", unary(Log10), y)
+	checkPropagation(t, "Erf", unary(MustErf), x)
+
+	if Conj(x) != x {
+		t.Errorf("Expected %v, received %v", x, Conj(x))
+	}
+
+	if larger := MustMax(x, y); larger.Real() != 2 || larger.(gcv.UncertainValue).Correlation(y) != 1 {
+		t.Errorf("Expected %v, received %v", y, larger)
+	}
+
+	if smaller := MustMin(x, gcv.MakeValue(1)); smaller.Real() != 0.5 || smaller.(gcv.UncertainValue).Correlation(x) != 1 {
+		t.Errorf("Expected %v, received %v", x, smaller)
+	}
+
+	if _, err := Floor(x); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := Ceil(x); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := Max(x, gcv.MakeValue(1i)); err == nil {
+		t.Errorf("Expected error")
+	}
+}
+
+func TestUncertainCorrelation(t *testing.T) {
+	x := gcv.MakeUncertain(2, 0.1)
+
+	// x - x has no error, while x - y for an independent y of the same size does
+	if difference := Sub(x, x); !difference.IsZero() {
+		t.Errorf("Expected %v, received %v", 0, difference)
+	}
+
+	y := gcv.MakeUncertain(2, 0.1)
+	if _, stdDev := gcv.UncertainParts(Sub(x, y)); math.Abs(stdDev-0.1*math.Sqrt2) > 1e-15 {
+		t.Errorf("Expected %v, received %v", 0.1*math.Sqrt2, stdDev)
+	}
+
+	// x*x is twice as uncertain, relatively, as x
+	if _, stdDev := gcv.UncertainParts(Mult(x, x)); math.Abs(stdDev-0.4) > 1e-15 {
+		t.Errorf("Expected %v, received %v", 0.4, stdDev)
+	}
+
+	// sin^2 + cos^2 is exactly 1 once the correlation is tracked
+	identity := Add(Mult(Sin(x), Sin(x)), Mult(Cos(x), Cos(x)))
+	if _, stdDev := gcv.UncertainParts(identity); stdDev > 1e-15 {
+		t.Errorf("Expected %v, received %v", 0, stdDev)
+	}
+
+	// making x independent loses the correlation
+	if _, stdDev := gcv.UncertainParts(Sub(x, gcv.MakeIndependent(x))); math.Abs(stdDev-0.1*math.Sqrt2) > 1e-15 {
+		t.Errorf("Expected %v, received %v", 0.1*math.Sqrt2, stdDev)
+	}
+}
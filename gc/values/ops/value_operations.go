@@ -8,40 +8,105 @@ import (
 	gcv "github.com/NumberXNumbers/types/gc/values"
 )
 
-// Add will add two gcv Values together
+// Add will add two gcv Values together.
+// Quantities of different Dimensions panic, use AddQuantity to get an error
 func Add(valueA gcv.Value, valueB gcv.Value) gcv.Value {
+	if isQuantity(valueA, valueB) {
+		return mustQuantity(AddQuantity(valueA, valueB))
+	}
+	if isUncertain(valueA, valueB) {
+		return uncertainAdd(valueA, valueB, 1)
+	}
+	if isInterval(valueA, valueB) {
+		return intervalAdd(valueA, valueB)
+	}
+	if isQuaternion(valueA, valueB) {
+		return quaternionAdd(valueA, valueB, 1)
+	}
 	if valueA.Type() == gcv.Complex || valueB.Type() == gcv.Complex {
 		return gcv.MakeValue(valueA.Complex() + valueB.Complex())
 	}
 	return gcv.MakeValue(valueA.Real() + valueB.Real())
 }
 
-// Sub will subtract two gcv Values together
+// Sub will subtract two gcv Values together.
+// Quantities of different Dimensions panic, use SubQuantity to get an error
 func Sub(valueA gcv.Value, valueB gcv.Value) gcv.Value {
+	if isQuantity(valueA, valueB) {
+		return mustQuantity(SubQuantity(valueA, valueB))
+	}
+	if isUncertain(valueA, valueB) {
+		return uncertainAdd(valueA, valueB, -1)
+	}
+	if isInterval(valueA, valueB) {
+		return intervalSub(valueA, valueB)
+	}
+	if isQuaternion(valueA, valueB) {
+		return quaternionAdd(valueA, valueB, -1)
+	}
 	if valueA.Type() == gcv.Complex || valueB.Type() == gcv.Complex {
 		return gcv.MakeValue(valueA.Complex() - valueB.Complex())
 	}
 	return gcv.MakeValue(valueA.Real() - valueB.Real())
 }
 
-// Mult will multiply two gcv Values together
+// Mult will multiply two gcv Values together.
+// Quaternion multiplication is not commutative, Mult(a, b) is a*b
 func Mult(valueA gcv.Value, valueB gcv.Value) gcv.Value {
+	if isQuantity(valueA, valueB) {
+		return quantityMult(valueA, valueB)
+	}
+	if isUncertain(valueA, valueB) {
+		return uncertainMult(valueA, valueB)
+	}
+	if isInterval(valueA, valueB) {
+		return intervalMult(valueA, valueB)
+	}
+	if isQuaternion(valueA, valueB) {
+		return quaternionMult(valueA, valueB)
+	}
 	if valueA.Type() == gcv.Complex || valueB.Type() == gcv.Complex {
 		return gcv.MakeValue(valueA.Complex() * valueB.Complex())
 	}
 	return gcv.MakeValue(valueA.Real() * valueB.Real())
 }
 
-// Div will divide two gcv Values together
+// Div will divide two gcv Values together.
+// For quaternions this is right division, a*b^-1
 func Div(valueA gcv.Value, valueB gcv.Value) gcv.Value {
+	if isQuantity(valueA, valueB) {
+		return quantityDiv(valueA, valueB)
+	}
+	if isUncertain(valueA, valueB) {
+		return uncertainDiv(valueA, valueB)
+	}
+	if isInterval(valueA, valueB) {
+		return intervalDiv(valueA, valueB)
+	}
+	if isQuaternion(valueA, valueB) {
+		return quaternionMult(valueA, quaternionInv(valueB))
+	}
 	if valueA.Type() == gcv.Complex || valueB.Type() == gcv.Complex {
 		return gcv.MakeValue(valueA.Complex() / valueB.Complex())
 	}
 	return gcv.MakeValue(valueA.Real() / valueB.Real())
 }
 
-// Sqrt returns the square root of a gcv Value
+// Sqrt returns the square root of a gcv Value.
+// Quantities with a Dimension that has no square root panic, use PowQuantity to get an error
 func Sqrt(value gcv.Value) gcv.Value {
+	if isQuantity(value) {
+		return mustQuantity(PowQuantity(value, gcv.MakeValue(0.5)))
+	}
+	if isUncertain(value) {
+		return uncertainFunc(value, math.Sqrt, func(x float64) float64 { return 1 / (2 * math.Sqrt(x)) })
+	}
+	if isInterval(value) {
+		return clamp(monotone(value, math.Sqrt, true, 0, math.Inf(1)), 0, math.Inf(1))
+	}
+	if isQuaternion(value) {
+		return quaternionFunc(value, cmplx.Sqrt)
+	}
 	if value.Type() == gcv.Complex {
 		return gcv.MakeValue(cmplx.Sqrt(value.Complex()))
 	}
@@ -50,6 +115,18 @@ func Sqrt(value gcv.Value) gcv.Value {
 
 // Abs returns the absolute value of a gcv Value
 func Abs(value gcv.Value) gcv.Value {
+	if isQuantity(value) {
+		return keepUnit(value, Abs)
+	}
+	if isUncertain(value) {
+		return uncertainFunc(value, math.Abs, func(x float64) float64 { return math.Copysign(1, x) })
+	}
+	if isInterval(value) {
+		return intervalAbs(value)
+	}
+	if isQuaternion(value) {
+		return gcv.MakeValue(quaternionAbs(value))
+	}
 	if value.Type() == gcv.Complex {
 		return gcv.MakeValue(cmplx.Abs(value.Complex()))
 	}
@@ -58,16 +135,52 @@ func Abs(value gcv.Value) gcv.Value {
 
 // Conj returns the conjugate of a gcv Value
 func Conj(value gcv.Value) gcv.Value {
+	if isQuantity(value) {
+		return keepUnit(value, Conj)
+	}
+	if isUncertain(value) {
+		return value
+	}
+	if isInterval(value) {
+		return value
+	}
+	if isQuaternion(value) {
+		return quaternionConj(value)
+	}
 	return gcv.MakeValue(cmplx.Conj(value.Complex()))
 }
 
 // Cot returns the cot of a gcv Value, meant for Value of type Complex
 func Cot(value gcv.Value) gcv.Value {
+	if isQuantity(value) {
+		return dimensionless(Cot, value)
+	}
+	if isUncertain(value) {
+		return uncertainFunc(value, func(x float64) float64 { return 1 / math.Tan(x) }, func(x float64) float64 { return -1 / (math.Sin(x) * math.Sin(x)) })
+	}
+	if isInterval(value) {
+		return intervalCot(value)
+	}
+	if isQuaternion(value) {
+		return quaternionFunc(value, cmplx.Cot)
+	}
 	return gcv.MakeValue(cmplx.Cot(value.Complex()))
 }
 
 // Sin returns the sine of a function
 func Sin(value gcv.Value) gcv.Value {
+	if isQuantity(value) {
+		return dimensionless(Sin, value)
+	}
+	if isUncertain(value) {
+		return uncertainFunc(value, math.Sin, math.Cos)
+	}
+	if isInterval(value) {
+		return intervalSin(value)
+	}
+	if isQuaternion(value) {
+		return quaternionFunc(value, cmplx.Sin)
+	}
 	if value.Type() == gcv.Complex {
 		return gcv.MakeValue(cmplx.Sin(value.Complex()))
 	}
@@ -76,6 +189,18 @@ func Sin(value gcv.Value) gcv.Value {
 
 // Cos returns the cosine of a function
 func Cos(value gcv.Value) gcv.Value {
+	if isQuantity(value) {
+		return dimensionless(Cos, value)
+	}
+	if isUncertain(value) {
+		return uncertainFunc(value, math.Cos, func(x float64) float64 { return -math.Sin(x) })
+	}
+	if isInterval(value) {
+		return intervalCos(value)
+	}
+	if isQuaternion(value) {
+		return quaternionFunc(value, cmplx.Cos)
+	}
 	if value.Type() == gcv.Complex {
 		return gcv.MakeValue(cmplx.Cos(value.Complex()))
 	}
@@ -84,6 +209,18 @@ func Cos(value gcv.Value) gcv.Value {
 
 // Tan returns the tangent of a function
 func Tan(value gcv.Value) gcv.Value {
+	if isQuantity(value) {
+		return dimensionless(Tan, value)
+	}
+	if isUncertain(value) {
+		return uncertainFunc(value, math.Tan, func(x float64) float64 { return 1 + math.Tan(x)*math.Tan(x) })
+	}
+	if isInterval(value) {
+		return intervalTan(value)
+	}
+	if isQuaternion(value) {
+		return quaternionFunc(value, cmplx.Tan)
+	}
 	if value.Type() == gcv.Complex {
 		return gcv.MakeValue(cmplx.Tan(value.Complex()))
 	}
@@ -92,6 +229,18 @@ func Tan(value gcv.Value) gcv.Value {
 
 // Asin returns the arcsine of a function
 func Asin(value gcv.Value) gcv.Value {
+	if isQuantity(value) {
+		return dimensionless(Asin, value)
+	}
+	if isUncertain(value) {
+		return uncertainFunc(value, math.Asin, func(x float64) float64 { return 1 / math.Sqrt(1-x*x) })
+	}
+	if isInterval(value) {
+		return monotone(value, math.Asin, true, -1, 1)
+	}
+	if isQuaternion(value) {
+		return quaternionFunc(value, cmplx.Asin)
+	}
 	if value.Type() == gcv.Complex {
 		return gcv.MakeValue(cmplx.Asin(value.Complex()))
 	}
@@ -100,6 +249,18 @@ func Asin(value gcv.Value) gcv.Value {
 
 // Acos returns the arccosine of a gcv Value
 func Acos(value gcv.Value) gcv.Value {
+	if isQuantity(value) {
+		return dimensionless(Acos, value)
+	}
+	if isUncertain(value) {
+		return uncertainFunc(value, math.Acos, func(x float64) float64 { return -1 / math.Sqrt(1-x*x) })
+	}
+	if isInterval(value) {
+		return clamp(monotone(value, math.Acos, false, -1, 1), 0, math.Inf(1))
+	}
+	if isQuaternion(value) {
+		return quaternionFunc(value, cmplx.Acos)
+	}
 	if value.Type() == gcv.Complex {
 		return gcv.MakeValue(cmplx.Acos(value.Complex()))
 	}
@@ -108,6 +269,18 @@ func Acos(value gcv.Value) gcv.Value {
 
 // Atan returns the arctangent of a gcv Value
 func Atan(value gcv.Value) gcv.Value {
+	if isQuantity(value) {
+		return dimensionless(Atan, value)
+	}
+	if isUncertain(value) {
+		return uncertainFunc(value, math.Atan, func(x float64) float64 { return 1 / (1 + x*x) })
+	}
+	if isInterval(value) {
+		return monotone(value, math.Atan, true, math.Inf(-1), math.Inf(1))
+	}
+	if isQuaternion(value) {
+		return quaternionFunc(value, cmplx.Atan)
+	}
 	if value.Type() == gcv.Complex {
 		return gcv.MakeValue(cmplx.Atan(value.Complex()))
 	}
@@ -116,6 +289,18 @@ func Atan(value gcv.Value) gcv.Value {
 
 // Sinh returns the hyperbolicSine of a gcv Value
 func Sinh(value gcv.Value) gcv.Value {
+	if isQuantity(value) {
+		return dimensionless(Sinh, value)
+	}
+	if isUncertain(value) {
+		return uncertainFunc(value, math.Sinh, math.Cosh)
+	}
+	if isInterval(value) {
+		return monotone(value, math.Sinh, true, math.Inf(-1), math.Inf(1))
+	}
+	if isQuaternion(value) {
+		return quaternionFunc(value, cmplx.Sinh)
+	}
 	if value.Type() == gcv.Complex {
 		return gcv.MakeValue(cmplx.Sinh(value.Complex()))
 	}
@@ -124,6 +309,18 @@ func Sinh(value gcv.Value) gcv.Value {
 
 // Cosh returns the hyperbolicCosine of a gcv Value
 func Cosh(value gcv.Value) gcv.Value {
+	if isQuantity(value) {
+		return dimensionless(Cosh, value)
+	}
+	if isUncertain(value) {
+		return uncertainFunc(value, math.Cosh, math.Sinh)
+	}
+	if isInterval(value) {
+		return intervalCosh(value)
+	}
+	if isQuaternion(value) {
+		return quaternionFunc(value, cmplx.Cosh)
+	}
 	if value.Type() == gcv.Complex {
 		return gcv.MakeValue(cmplx.Cosh(value.Complex()))
 	}
@@ -132,6 +329,18 @@ func Cosh(value gcv.Value) gcv.Value {
 
 // Tanh returns the hyperbolicTangent of a gcv Value
 func Tanh(value gcv.Value) gcv.Value {
+	if isQuantity(value) {
+		return dimensionless(Tanh, value)
+	}
+	if isUncertain(value) {
+		return uncertainFunc(value, math.Tanh, func(x float64) float64 { return 1 - math.Tanh(x)*math.Tanh(x) })
+	}
+	if isInterval(value) {
+		return clamp(monotone(value, math.Tanh, true, math.Inf(-1), math.Inf(1)), -1, 1)
+	}
+	if isQuaternion(value) {
+		return quaternionFunc(value, cmplx.Tanh)
+	}
 	if value.Type() == gcv.Complex {
 		return gcv.MakeValue(cmplx.Tanh(value.Complex()))
 	}
@@ -140,6 +349,18 @@ func Tanh(value gcv.Value) gcv.Value {
 
 // Asinh returns the inverseHyperbolicSine of a gcv Value
 func Asinh(value gcv.Value) gcv.Value {
+	if isQuantity(value) {
+		return dimensionless(Asinh, value)
+	}
+	if isUncertain(value) {
+		return uncertainFunc(value, math.Asinh, func(x float64) float64 { return 1 / math.Sqrt(x*x+1) })
+	}
+	if isInterval(value) {
+		return monotone(value, math.Asinh, true, math.Inf(-1), math.Inf(1))
+	}
+	if isQuaternion(value) {
+		return quaternionFunc(value, cmplx.Asinh)
+	}
 	if value.Type() == gcv.Complex {
 		return gcv.MakeValue(cmplx.Asinh(value.Complex()))
 	}
@@ -148,6 +369,18 @@ func Asinh(value gcv.Value) gcv.Value {
 
 // Acosh returns the inverseHyperbolicCosine of a gcv Value
 func Acosh(value gcv.Value) gcv.Value {
+	if isQuantity(value) {
+		return dimensionless(Acosh, value)
+	}
+	if isUncertain(value) {
+		return uncertainFunc(value, math.Acosh, func(x float64) float64 { return 1 / math.Sqrt(x*x-1) })
+	}
+	if isInterval(value) {
+		return clamp(monotone(value, math.Acosh, true, 1, math.Inf(1)), 0, math.Inf(1))
+	}
+	if isQuaternion(value) {
+		return quaternionFunc(value, cmplx.Acosh)
+	}
 	if value.Type() == gcv.Complex {
 		return gcv.MakeValue(cmplx.Acosh(value.Complex()))
 	}
@@ -156,14 +389,58 @@ func Acosh(value gcv.Value) gcv.Value {
 
 // Atanh returns the inverseHyperbolicTangent of a gcv Value
 func Atanh(value gcv.Value) gcv.Value {
+	if isQuantity(value) {
+		return dimensionless(Atanh, value)
+	}
+	if isUncertain(value) {
+		return uncertainFunc(value, math.Atanh, func(x float64) float64 { return 1 / (1 - x*x) })
+	}
+	if isInterval(value) {
+		return monotone(value, math.Atanh, true, -1, 1)
+	}
+	if isQuaternion(value) {
+		return quaternionFunc(value, cmplx.Atanh)
+	}
 	if value.Type() == gcv.Complex {
 		return gcv.MakeValue(cmplx.Atanh(value.Complex()))
 	}
 	return gcv.MakeValue(math.Atanh(value.Real()))
 }
 
+// Exp returns e raised to the power of gcv Value
+func Exp(value gcv.Value) gcv.Value {
+	if isQuantity(value) {
+		return dimensionless(Exp, value)
+	}
+	if isUncertain(value) {
+		return uncertainFunc(value, math.Exp, math.Exp)
+	}
+	if isInterval(value) {
+		return intervalExp(value)
+	}
+	if isQuaternion(value) {
+		return quaternionFunc(value, cmplx.Exp)
+	}
+	if value.Type() == gcv.Complex {
+		return gcv.MakeValue(cmplx.Exp(value.Complex()))
+	}
+	return gcv.MakeValue(math.Exp(value.Real()))
+}
+
 // Log returns the natural log of gcv Value
 func Log(value gcv.Value) gcv.Value {
+	if isQuantity(value) {
+		return dimensionless(Log, value)
+	}
+	if isUncertain(value) {
+		return uncertainFunc(value, math.Log, func(x float64) float64 { return 1 / x })
+	}
+	if isInterval(value) {
+		return intervalLog(value)
+	}
+	if isQuaternion(value) {
+		return quaternionFunc(value, cmplx.Log)
+	}
 	if value.Type() == gcv.Complex {
 		return gcv.MakeValue(cmplx.Log(value.Complex()))
 	}
@@ -172,22 +449,66 @@ func Log(value gcv.Value) gcv.Value {
 
 // Log10 returns the log base 10 of gcv Value
 func Log10(value gcv.Value) gcv.Value {
+	if isQuantity(value) {
+		return dimensionless(Log10, value)
+	}
+	if isUncertain(value) {
+		return uncertainFunc(value, math.Log10, func(x float64) float64 { return 1 / (x * math.Ln10) })
+	}
+	if isInterval(value) {
+		return monotone(value, math.Log10, true, 0, math.Inf(1))
+	}
+	if isQuaternion(value) {
+		return quaternionFunc(value, cmplx.Log10)
+	}
 	if value.Type() == gcv.Complex {
 		return gcv.MakeValue(cmplx.Log10(value.Complex()))
 	}
 	return gcv.MakeValue(math.Log10(value.Real()))
 }
 
-// LogBase returns the log of gcv Value valueA in base of gcv Value valueB
+// LogBase returns the log of gcv Value valueA in base of gcv Value valueB.
+// Quantities with a Dimension panic
 func LogBase(valueA gcv.Value, valueB gcv.Value) gcv.Value {
+	if isQuantity(valueA, valueB) {
+		return mustQuantity(ApplyDimensionless(func(a gcv.Value) gcv.Value {
+			return dimensionless(func(b gcv.Value) gcv.Value { return LogBase(a, b) }, valueB)
+		}, valueA))
+	}
+	if isUncertain(valueA, valueB) {
+		return uncertainLogBase(valueA, valueB)
+	}
+	if isInterval(valueA, valueB) {
+		return intervalDiv(intervalLog(valueA), intervalLog(valueB))
+	}
+	if isQuaternion(valueA, valueB) {
+		return Div(Log(valueA), Log(valueB))
+	}
 	if valueA.Type() == gcv.Complex || valueB.Type() == gcv.Complex {
 		return gcv.MakeValue(cmplx.Log(valueA.Complex()) / cmplx.Log(valueB.Complex()))
 	}
 	return gcv.MakeValue(math.Log(valueA.Real()) / math.Log(valueB.Real()))
 }
 
-// Pow returns the power of gcv Value valueA raised to the power of gcv Value valueB
+// Pow returns the power of gcv Value valueA raised to the power of gcv Value valueB.
+// For quaternions with a non Real exponent this is Exp(Log(valueA)*valueB).
+// Quantities that can not be raised to valueB panic, use PowQuantity to get an error
 func Pow(valueA gcv.Value, valueB gcv.Value) gcv.Value {
+	if isQuantity(valueA, valueB) {
+		return mustQuantity(PowQuantity(valueA, valueB))
+	}
+	if isUncertain(valueA, valueB) {
+		return uncertainPow(valueA, valueB)
+	}
+	if isInterval(valueA, valueB) {
+		return intervalPow(valueA, valueB)
+	}
+	if isQuaternion(valueA, valueB) {
+		if valueB.Type() == gcv.Real {
+			return quaternionFunc(valueA, func(z complex128) complex128 { return cmplx.Pow(z, valueB.Complex()) })
+		}
+		return Exp(Mult(Log(valueA), valueB))
+	}
 	if valueA.Type() == gcv.Complex || valueB.Type() == gcv.Complex {
 		return gcv.MakeValue(cmplx.Pow(valueA.Complex(), valueB.Complex()))
 	}
@@ -195,10 +516,19 @@ func Pow(valueA gcv.Value, valueB gcv.Value) gcv.Value {
 }
 
 // Mod returns the modulo of a real Value valueA by a real Value valueB.
-// if either Value is of type Complex an error is returned
+// if either Value is not of type Real an error is returned
 func Mod(valueA gcv.Value, valueB gcv.Value) (gcv.Value, error) {
-	if valueA.Type() == gcv.Complex || valueB.Type() == gcv.Complex {
-		return nil, errors.New("Modulo is not supported for Complex numbers")
+	if isQuantity(valueA, valueB) {
+		return quantityCompare(valueA, valueB, "take the modulo of", Mod)
+	}
+	if isUncertain(valueA, valueB) {
+		return uncertainMod(valueA, valueB), nil
+	}
+	if isInterval(valueA, valueB) {
+		return intervalMod(valueA, valueB), nil
+	}
+	if valueA.Type() != gcv.Real || valueB.Type() != gcv.Real {
+		return nil, errors.New("Modulo is only supported for Real numbers")
 	}
 	return gcv.MakeValue(math.Mod(valueA.Real(), valueB.Real())), nil
 }
@@ -212,11 +542,43 @@ func MustMod(valueA gcv.Value, valueB gcv.Value) gcv.Value {
 	return value
 }
 
+// Atan2 returns the arc tangent of real Value valueA/valueB, using the signs of both to find the quadrant.
+// if either Value is not of type Real an error is returned
+func Atan2(valueA gcv.Value, valueB gcv.Value) (gcv.Value, error) {
+	if valueA.Type() != gcv.Real || valueB.Type() != gcv.Real {
+		return nil, errors.New("Atan2 is only supported for Real numbers")
+	}
+	return gcv.MakeValue(math.Atan2(valueA.Real(), valueB.Real())), nil
+}
+
+// MustAtan2 is the same as Atan2 but will panic if either value is not Real
+func MustAtan2(valueA gcv.Value, valueB gcv.Value) gcv.Value {
+	value, err := Atan2(valueA, valueB)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
 // Floor returns the floor (rounded down) of a gcv Value.
-// if either Value is of type Complex an error is returned
+// if value is not of type Real an error is returned
 func Floor(value gcv.Value) (gcv.Value, error) {
-	if value.Type() == gcv.Complex {
-		return nil, errors.New("Floor is not supported for Complex numbers")
+	if isQuantity(value) {
+		magnitude, unit := gcv.QuantityParts(value)
+		result, err := Floor(magnitude)
+		if err != nil {
+			return nil, err
+		}
+		return makeQuantity(result, unit), nil
+	}
+	if isUncertain(value) {
+		return nil, errors.New("Floor is not supported for Uncertain Values")
+	}
+	if isInterval(value) {
+		return endpointwise(value, math.Floor), nil
+	}
+	if value.Type() != gcv.Real {
+		return nil, errors.New("Floor is only supported for Real numbers")
 	}
 	return gcv.MakeValue(math.Floor(value.Real())), nil
 }
@@ -231,10 +593,24 @@ func MustFloor(value gcv.Value) gcv.Value {
 }
 
 // Ceil returns the ceil (rounded up) of a gcv Value
-// if either Value is of type Complex an error is returned
+// if value is not of type Real an error is returned
 func Ceil(value gcv.Value) (gcv.Value, error) {
-	if value.Type() == gcv.Complex {
-		return nil, errors.New("Ceil is not supported for Complex numbers")
+	if isQuantity(value) {
+		magnitude, unit := gcv.QuantityParts(value)
+		result, err := Ceil(magnitude)
+		if err != nil {
+			return nil, err
+		}
+		return makeQuantity(result, unit), nil
+	}
+	if isUncertain(value) {
+		return nil, errors.New("Ceil is not supported for Uncertain Values")
+	}
+	if isInterval(value) {
+		return endpointwise(value, math.Ceil), nil
+	}
+	if value.Type() != gcv.Real {
+		return nil, errors.New("Ceil is only supported for Real numbers")
 	}
 	return gcv.MakeValue(math.Ceil(value.Real())), nil
 }
@@ -249,10 +625,21 @@ func MustCeil(value gcv.Value) gcv.Value {
 }
 
 // Max returns the max of two gcv Value
-// if either Value is of type Complex an error is returned
+// if either Value is not of type Real an error is returned
 func Max(valueA gcv.Value, valueB gcv.Value) (gcv.Value, error) {
-	if valueA.Type() == gcv.Complex || valueB.Type() == gcv.Complex {
-		return nil, errors.New("Max is not supported for Complex numbers")
+	if isQuantity(valueA, valueB) {
+		return quantityCompare(valueA, valueB, "compare", Max)
+	}
+	if isUncertain(valueA, valueB) {
+		return uncertainSelect(valueA, valueB, valueA.Real() >= valueB.Real())
+	}
+	if isInterval(valueA, valueB) {
+		lowerA, upperA := gcv.IntervalBounds(valueA)
+		lowerB, upperB := gcv.IntervalBounds(valueB)
+		return gcv.MakeInterval(math.Max(lowerA, lowerB), math.Max(upperA, upperB)), nil
+	}
+	if valueA.Type() != gcv.Real || valueB.Type() != gcv.Real {
+		return nil, errors.New("Max is only supported for Real numbers")
 	}
 	return gcv.MakeValue(math.Max(valueA.Real(), valueB.Real())), nil
 }
@@ -267,10 +654,21 @@ func MustMax(valueA gcv.Value, valueB gcv.Value) gcv.Value {
 }
 
 // Min returns the minimum of two gcv Value.
-// if either Value is of type Complex an error is returned
+// if either Value is not of type Real an error is returned
 func Min(valueA gcv.Value, valueB gcv.Value) (gcv.Value, error) {
-	if valueA.Type() == gcv.Complex || valueB.Type() == gcv.Complex {
-		return nil, errors.New("Min is not supported for Complex numbers")
+	if isQuantity(valueA, valueB) {
+		return quantityCompare(valueA, valueB, "compare", Min)
+	}
+	if isUncertain(valueA, valueB) {
+		return uncertainSelect(valueA, valueB, valueA.Real() <= valueB.Real())
+	}
+	if isInterval(valueA, valueB) {
+		lowerA, upperA := gcv.IntervalBounds(valueA)
+		lowerB, upperB := gcv.IntervalBounds(valueB)
+		return gcv.MakeInterval(math.Min(lowerA, lowerB), math.Min(upperA, upperB)), nil
+	}
+	if valueA.Type() != gcv.Real || valueB.Type() != gcv.Real {
+		return nil, errors.New("Min is only supported for Real numbers")
 	}
 	return gcv.MakeValue(math.Min(valueA.Real(), valueB.Real())), nil
 }
@@ -285,10 +683,23 @@ func MustMin(valueA gcv.Value, valueB gcv.Value) gcv.Value {
 }
 
 // Erf returns the error function of a gcv Value.
-// if either Value is of type Complex an error is returned
+// if value is not of type Real an error is returned
 func Erf(value gcv.Value) (gcv.Value, error) {
-	if value.Type() == gcv.Complex {
-		return nil, errors.New("Erf is not supported for Complex numbers")
+	if isQuantity(value) {
+		magnitude, err := inUnit(value, gcv.Dimensionless())
+		if err != nil {
+			return nil, errors.New("Erf is not supported for Values with a Dimension")
+		}
+		return Erf(magnitude)
+	}
+	if isUncertain(value) {
+		return uncertainFunc(value, math.Erf, func(x float64) float64 { return 2 / math.SqrtPi * math.Exp(-x*x) }), nil
+	}
+	if isInterval(value) {
+		return clamp(monotone(value, math.Erf, true, math.Inf(-1), math.Inf(1)), -1, 1), nil
+	}
+	if value.Type() != gcv.Real {
+		return nil, errors.New("Erf is only supported for Real numbers")
 	}
 	return gcv.MakeValue(math.Erf(value.Real())), nil
 }
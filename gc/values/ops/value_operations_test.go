@@ -384,6 +384,22 @@ func TestMustMod(t *testing.T) {
 	}
 }
 
+func TestAtan2(t *testing.T) {
+	resultA, errA := Atan2(gcv.MakeValue(1), gcv.MakeValue(-1))
+	solution = gcv.MakeValue(3 * math.Pi / 4)
+	if errA != nil {
+		t.Errorf("Unexpected Error: %v", errA)
+	}
+	if !reflect.DeepEqual(resultA, solution) {
+		t.Errorf("Expected %v, received %v", solution, resultA)
+	}
+
+	_, errB := Atan2(testValueC, testValueD)
+	if errB == nil {
+		t.Error("Expected An Error")
+	}
+}
+
 func TestMax(t *testing.T) {
 	resultA, errA := Max(testValueB, testValueA)
 	solution = gcv.MakeValue(2)
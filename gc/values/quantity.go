@@ -0,0 +1,89 @@
+package values
+
+import "fmt"
+
+// QuantityValue is a Value of type Quantity, a Magnitude measured in a Unit.
+// Real, Imag and Complex return the parts of the Magnitude in its own Unit
+type QuantityValue interface {
+	Value
+
+	// returns the magnitude of the quantity in its unit
+	Magnitude() Value
+
+	// returns the unit of the quantity
+	Unit() Unit
+}
+
+type quantity struct {
+	magnitude Value
+	unit      Unit
+}
+
+func (q *quantity) Magnitude() Value { return q.magnitude }
+
+func (q *quantity) Unit() Unit { return q.unit }
+
+func (q *quantity) Real() float64 { return q.magnitude.Real() }
+
+func (q *quantity) Imag() float64 { return q.magnitude.Imag() }
+
+func (q *quantity) Complex() complex128 { return q.magnitude.Complex() }
+
+func (q *quantity) Type() Type { return Quantity }
+
+func (q *quantity) IsZero() bool { return q.magnitude.IsZero() }
+
+func (q *quantity) String() string {
+	if q.unit.String() == "" {
+		return q.magnitude.String()
+	}
+	return fmt.Sprintf("%s %s", q.magnitude, q.unit)
+}
+
+// MakeQuantity returns the Quantity Value of magnitude in the unit described by unit,
+// see ParseUnit. Returns error if unit can not be parsed
+func MakeQuantity(magnitude interface{}, unit string) (Value, error) {
+	u, err := ParseUnit(unit)
+	if err != nil {
+		return nil, err
+	}
+	return MakeQuantityAlt(MakeValue(magnitude), u), nil
+}
+
+// MustMakeQuantity is the same as MakeQuantity but will panic
+func MustMakeQuantity(magnitude interface{}, unit string) Value {
+	q, err := MakeQuantity(magnitude, unit)
+	if err != nil {
+		panic(err)
+	}
+	return q
+}
+
+// MakeQuantityAlt returns the Quantity Value of magnitude in Unit unit.
+// If magnitude is already a Quantity its own unit is multiplied by unit
+func MakeQuantityAlt(magnitude Value, unit Unit) Value {
+	if inner, ok := magnitude.(QuantityValue); ok {
+		magnitude, unit = inner.Magnitude(), inner.Unit().Mult(unit)
+	}
+	q := new(quantity)
+	q.magnitude = magnitude
+	q.unit = unit
+	return q
+}
+
+// QuantityParts returns the magnitude and Unit of val. Values that are not a Quantity are dimensionless
+func QuantityParts(val Value) (magnitude Value, unit Unit) {
+	if q, ok := val.(QuantityValue); ok {
+		return q.Magnitude(), q.Unit()
+	}
+	return val, Dimensionless()
+}
+
+// ConvertScale returns the number that a magnitude in Unit from is multiplied by to be in Unit to.
+// Returns error if the units are not of the same Dimension
+func ConvertScale(from Unit, to Unit) (float64, error) {
+	if !from.Compatible(to) {
+		return 0, fmt.Errorf("Can not convert %s [%s] to %s [%s]", from, from.Dimension(), to, to.Dimension())
+	}
+	return from.Scale() / to.Scale(), nil
+}
@@ -0,0 +1,77 @@
+package values
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// QuaternionValue is a Value of type Quaternion, w + xi + yj + zk.
+// Real returns w, Imag returns x and Complex returns w + xi
+type QuaternionValue interface {
+	Value
+
+	// returns the j part of a quaternion
+	Jmag() float64
+
+	// returns the k part of a quaternion
+	Kmag() float64
+}
+
+type quaternion struct {
+	real      float64
+	imaginary float64
+	jmag      float64
+	kmag      float64
+}
+
+func (q *quaternion) Real() float64 { return q.real }
+
+func (q *quaternion) Imag() float64 { return q.imaginary }
+
+func (q *quaternion) Jmag() float64 { return q.jmag }
+
+func (q *quaternion) Kmag() float64 { return q.kmag }
+
+func (q *quaternion) Complex() complex128 { return complex(q.real, q.imaginary) }
+
+func (q *quaternion) Type() Type { return Quaternion }
+
+func (q *quaternion) IsZero() bool {
+	return q.real == 0 && q.imaginary == 0 && q.jmag == 0 && q.kmag == 0
+}
+
+func (q *quaternion) String() string {
+	return fmt.Sprintf("(%s%si%sj%sk)", formatPart(q.real, false), formatPart(q.imaginary, true),
+		formatPart(q.jmag, true), formatPart(q.kmag, true))
+}
+
+func formatPart(part float64, signed bool) string {
+	str := strconv.FormatFloat(part, 'g', -1, 64)
+	if signed && str[0] != '-' {
+		return "+" + str
+	}
+	return str
+}
+
+// MakeQuaternion returns the Value w + xi + yj + zk.
+// If y and z are zero a Real or Complex Value is returned
+func MakeQuaternion(w, x, y, z float64) Value {
+	if y == 0 && z == 0 {
+		return MakeValue(complex(w, x))
+	}
+	q := new(quaternion)
+	q.real = w
+	q.imaginary = x
+	q.jmag = y
+	q.kmag = z
+	return q
+}
+
+// QuaternionParts returns the w, x, y and z parts of val as a quaternion w + xi + yj + zk.
+// y and z are zero for Real and Complex Values
+func QuaternionParts(val Value) (w, x, y, z float64) {
+	if q, ok := val.(QuaternionValue); ok {
+		return q.Real(), q.Imag(), q.Jmag(), q.Kmag()
+	}
+	return val.Real(), val.Imag(), 0, 0
+}
@@ -0,0 +1,75 @@
+package values
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMakeQuaternion(t *testing.T) {
+	quaternion := MakeQuaternion(1, 2, 3, 4)
+	if quaternion.Type() != Quaternion {
+		t.Errorf("Expected %v, received %v", Quaternion, quaternion.Type())
+	}
+
+	if quaternion.Complex() != 1+2i {
+		t.Errorf("Expected %v, received %v", 1+2i, quaternion.Complex())
+	}
+
+	if quaternion.IsZero() {
+		t.Errorf("Expected %t, received %t", false, quaternion.IsZero())
+	}
+
+	if quaternion.String() != "(1+2i+3j+4k)" {
+		t.Errorf("Expected %s, received %s", "(1+2i+3j+4k)", quaternion.String())
+	}
+
+	negative := MakeQuaternion(-1.5, 0, -3, 4)
+	if negative.String() != "(-1.5+0i-3j+4k)" {
+		t.Errorf("Expected %s, received %s", "(-1.5+0i-3j+4k)", negative.String())
+	}
+
+	w, x, y, z := QuaternionParts(quaternion)
+	if w != 1 || x != 2 || y != 3 || z != 4 {
+		t.Errorf("Expected %v, received %v", []float64{1, 2, 3, 4}, []float64{w, x, y, z})
+	}
+
+	complexValue := MakeQuaternion(1, 2, 0, 0)
+	if !reflect.DeepEqual(complexValue, MakeValue(1+2i)) {
+		t.Errorf("Expected %v, received %v", MakeValue(1+2i), complexValue)
+	}
+
+	w, x, y, z = QuaternionParts(complexValue)
+	if w != 1 || x != 2 || y != 0 || z != 0 {
+		t.Errorf("Expected %v, received %v", []float64{1, 2, 0, 0}, []float64{w, x, y, z})
+	}
+
+	realValue := MakeQuaternion(3, 0, 0, 0)
+	if realValue.Type() != Real {
+		t.Errorf("Expected %v, received %v", Real, realValue.Type())
+	}
+
+	if !reflect.DeepEqual(MakeValue(quaternion), quaternion) {
+		t.Errorf("Expected %v, received %v", quaternion, MakeValue(quaternion))
+	}
+}
+
+func TestQuaternionValues(t *testing.T) {
+	quaternion := MakeQuaternion(1, 0, 1, 0)
+	values := MakeValues(1, 1+1i)
+	if values.Type() != Complex {
+		t.Errorf("Expected %v, received %v", Complex, values.Type())
+	}
+
+	values.Set(0, quaternion)
+	if values.Type() != Quaternion {
+		t.Errorf("Expected %v, received %v", Quaternion, values.Type())
+	}
+
+	if values.IndexOf(MakeQuaternion(1, 0, 1, 0)) != 0 {
+		t.Errorf("Expected %d, received %d", 0, values.IndexOf(MakeQuaternion(1, 0, 1, 0)))
+	}
+
+	if values.IndexOf(MakeQuaternion(1, 0, 0, 1)) != -1 {
+		t.Errorf("Expected %d, received %d", -1, values.IndexOf(MakeQuaternion(1, 0, 0, 1)))
+	}
+}
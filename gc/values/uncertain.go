@@ -0,0 +1,311 @@
+package values
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"sync/atomic"
+)
+
+// sources counts the independent sources of error made so far, giving each a unique id
+var sources uint64
+
+// UncertainValue is a Value of type Uncertain, a measurement of Mean plus or minus StdDev.
+// Real returns the mean and Imag returns 0. The error of an Uncertain Value is kept as the
+// contributions of independent sources of error, so Values computed from the same measurements
+// stay correlated
+type UncertainValue interface {
+	Value
+
+	// returns the mean of the value
+	Mean() float64
+
+	// returns the standard deviation of the value
+	StdDev() float64
+
+	// returns the covariance between the value and val
+	Covariance(val Value) float64
+
+	// returns the correlation coefficient between the value and val
+	Correlation(val Value) float64
+}
+
+type uncertain struct {
+	mean float64
+
+	// components maps the id of each source of error to its contribution to the standard deviation
+	components map[uint64]float64
+}
+
+func (u *uncertain) Mean() float64 { return u.mean }
+
+func (u *uncertain) StdDev() float64 {
+	variance := 0.0
+	for _, component := range u.components {
+		variance += component * component
+	}
+	return math.Sqrt(variance)
+}
+
+func (u *uncertain) Covariance(val Value) float64 {
+	covariance := 0.0
+	for source, component := range componentsOf(val) {
+		covariance += u.components[source] * component
+	}
+	return covariance
+}
+
+func (u *uncertain) Correlation(val Value) float64 {
+	_, stdDev := UncertainParts(val)
+	if u.StdDev() == 0 || stdDev == 0 {
+		return 0
+	}
+	return u.Covariance(val) / (u.StdDev() * stdDev)
+}
+
+func (u *uncertain) Real() float64 { return u.mean }
+
+func (u *uncertain) Imag() float64 { return 0 }
+
+func (u *uncertain) Complex() complex128 { return complex(u.mean, 0) }
+
+func (u *uncertain) Type() Type { return Uncertain }
+
+func (u *uncertain) IsZero() bool { return u.mean == 0 && u.StdDev() == 0 }
+
+func (u *uncertain) String() string {
+	return fmt.Sprintf("(%s±%s)", strconv.FormatFloat(u.mean, 'g', -1, 64), strconv.FormatFloat(u.StdDev(), 'g', -1, 64))
+}
+
+// componentsOf returns the error components of val. Values that are not Uncertain have none
+func componentsOf(val Value) map[uint64]float64 {
+	if u, ok := val.(*uncertain); ok {
+		return u.components
+	}
+	return nil
+}
+
+// newSource returns the id of a new independent source of error
+func newSource() uint64 { return atomic.AddUint64(&sources, 1) }
+
+// MakeUncertain returns the Uncertain Value mean plus or minus stdDev.
+// Each call is a new measurement with an error independent of every other Value
+func MakeUncertain(mean, stdDev float64) Value {
+	u := new(uncertain)
+	u.mean = mean
+	u.components = make(map[uint64]float64)
+	if stdDev != 0 {
+		u.components[newSource()] = math.Abs(stdDev)
+	}
+	return u
+}
+
+// MakeIndependent returns an Uncertain Value with the same mean and standard deviation as val,
+// but with no correlation to any other Value. This turns off correlation tracking for val
+func MakeIndependent(val Value) Value {
+	mean, stdDev := UncertainParts(val)
+	return MakeUncertain(mean, stdDev)
+}
+
+// MakeCorrelated returns Uncertain Values with the given means and covariance matrix.
+// Returns error if covariance is not a symmetric positive semi-definite matrix matching means in size
+func MakeCorrelated(means []float64, covariance [][]float64) ([]Value, error) {
+	n := len(means)
+	if len(covariance) != n {
+		return nil, errors.New("Covariance matrix does not match the number of means")
+	}
+	for i := range covariance {
+		if len(covariance[i]) != n {
+			return nil, errors.New("Covariance matrix is not square")
+		}
+		for j := 0; j < i; j++ {
+			if covariance[i][j] != covariance[j][i] {
+				return nil, errors.New("Covariance matrix is not symmetric")
+			}
+		}
+	}
+
+	// Cholesky factor covariance = L*L^T, each column of L is an independent source of error.
+	// Zero pivots are allowed so perfectly correlated Values can be made
+	lower := make([][]float64, n)
+	for i := range lower {
+		lower[i] = make([]float64, n)
+	}
+	for j := 0; j < n; j++ {
+		pivot := covariance[j][j]
+		for k := 0; k < j; k++ {
+			pivot -= lower[j][k] * lower[j][k]
+		}
+		tolerance := 1e-12 * math.Max(1, math.Abs(covariance[j][j]))
+		if pivot < -tolerance {
+			return nil, errors.New("Covariance matrix is not positive semi-definite")
+		}
+		if pivot <= tolerance {
+			for i := j + 1; i < n; i++ {
+				rest := covariance[i][j]
+				for k := 0; k < j; k++ {
+					rest -= lower[i][k] * lower[j][k]
+				}
+				if math.Abs(rest) > 1e-12*math.Max(1, math.Abs(covariance[i][j])) {
+					return nil, errors.New("Covariance matrix is not positive semi-definite")
+				}
+			}
+			continue
+		}
+		lower[j][j] = math.Sqrt(pivot)
+		for i := j + 1; i < n; i++ {
+			sum := covariance[i][j]
+			for k := 0; k < j; k++ {
+				sum -= lower[i][k] * lower[j][k]
+			}
+			lower[i][j] = sum / lower[j][j]
+		}
+	}
+
+	ids := make([]uint64, n)
+	for j := range ids {
+		ids[j] = newSource()
+	}
+	vals := make([]Value, n)
+	for i := 0; i < n; i++ {
+		u := new(uncertain)
+		u.mean = means[i]
+		u.components = make(map[uint64]float64)
+		for j := 0; j <= i; j++ {
+			if lower[i][j] != 0 {
+				u.components[ids[j]] = lower[i][j]
+			}
+		}
+		vals[i] = u
+	}
+	return vals, nil
+}
+
+// MustMakeCorrelated is the same as MakeCorrelated but will panic
+func MustMakeCorrelated(means []float64, covariance [][]float64) []Value {
+	vals, err := MakeCorrelated(means, covariance)
+	if err != nil {
+		panic(err)
+	}
+	return vals
+}
+
+// Propagate returns the Uncertain Value with the given mean whose error is the sum of the errors
+// of vals each scaled by the matching derivative. This is linear error propagation through a
+// function with those partial derivatives. vals and derivatives must be the same length
+func Propagate(mean float64, vals []Value, derivatives []float64) Value {
+	u := new(uncertain)
+	u.mean = mean
+	u.components = make(map[uint64]float64)
+	for index, val := range vals {
+		for source, component := range componentsOf(val) {
+			u.components[source] += derivatives[index] * component
+		}
+	}
+	for source, component := range u.components {
+		if component == 0 {
+			delete(u.components, source)
+		}
+	}
+	return u
+}
+
+// UncertainParts returns the mean and standard deviation of val.
+// Real Values have a standard deviation of 0. Other Values are not real measurements and return NaN
+func UncertainParts(val Value) (mean, stdDev float64) {
+	if u, ok := val.(UncertainValue); ok {
+		return u.Mean(), u.StdDev()
+	}
+	if val.Type() != Real {
+		return math.NaN(), math.NaN()
+	}
+	return val.Real(), 0
+}
+
+// Mean returns the arithmetic mean of vals, with the uncertainty propagated.
+// Returns error if vals is empty or holds Values that are not Real or Uncertain
+func Mean(vals Values) (Value, error) {
+	weights := make([]float64, vals.Len())
+	for index := range weights {
+		weights[index] = 1
+	}
+	return WeightedMean(vals, weights)
+}
+
+// MustMean is the same as Mean but will panic
+func MustMean(vals Values) Value {
+	mean, err := Mean(vals)
+	if err != nil {
+		panic(err)
+	}
+	return mean
+}
+
+// WeightedMean returns the mean of vals weighted by weights, with the uncertainty propagated.
+// Returns error if the lengths do not match, a weight is negative, the weights sum to 0
+// or vals holds Values that are not Real or Uncertain
+func WeightedMean(vals Values, weights []float64) (Value, error) {
+	if vals.Len() == 0 {
+		return nil, errors.New("Values is empty")
+	}
+	if vals.Len() != len(weights) {
+		return nil, errors.New("Length of Values and weights does not match")
+	}
+	total := 0.0
+	for _, weight := range weights {
+		if weight < 0 {
+			return nil, errors.New("Weights must not be negative")
+		}
+		total += weight
+	}
+	if total == 0 {
+		return nil, errors.New("Weights sum to 0")
+	}
+
+	elements := make([]Value, vals.Len())
+	derivatives := make([]float64, vals.Len())
+	mean := 0.0
+	for index := range elements {
+		elements[index] = vals.Get(index)
+		if elements[index].Type() != Real && elements[index].Type() != Uncertain {
+			return nil, errors.New("Values must be Real or Uncertain")
+		}
+		derivatives[index] = weights[index] / total
+		mean += derivatives[index] * elements[index].Real()
+	}
+	return Propagate(mean, elements, derivatives), nil
+}
+
+// MustWeightedMean is the same as WeightedMean but will panic
+func MustWeightedMean(vals Values, weights []float64) Value {
+	mean, err := WeightedMean(vals, weights)
+	if err != nil {
+		panic(err)
+	}
+	return mean
+}
+
+// InverseVarianceMean returns the mean of vals weighted by one over their variances,
+// the best estimate from independent measurements of the same quantity.
+// Returns error if any Value has a standard deviation of 0 or is not Real or Uncertain
+func InverseVarianceMean(vals Values) (Value, error) {
+	weights := make([]float64, vals.Len())
+	for index := range weights {
+		_, stdDev := UncertainParts(vals.Get(index))
+		if stdDev == 0 || math.IsNaN(stdDev) {
+			return nil, errors.New("Values must have a non zero standard deviation")
+		}
+		weights[index] = 1 / (stdDev * stdDev)
+	}
+	return WeightedMean(vals, weights)
+}
+
+// MustInverseVarianceMean is the same as InverseVarianceMean but will panic
+func MustInverseVarianceMean(vals Values) Value {
+	mean, err := InverseVarianceMean(vals)
+	if err != nil {
+		panic(err)
+	}
+	return mean
+}
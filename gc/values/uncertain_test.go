@@ -0,0 +1,176 @@
+package values
+
+import (
+	"math"
+	"testing"
+)
+
+func closeTo(a, b float64) bool { return math.Abs(a-b) <= 1e-12*math.Max(1, math.Abs(b)) }
+
+func TestMakeUncertain(t *testing.T) {
+	value := MakeUncertain(1.5, -0.25)
+	if value.Type() != Uncertain {
+		t.Errorf("Expected %v, received %v", Uncertain, value.Type())
+	}
+
+	uncertain := value.(UncertainValue)
+	if uncertain.Mean() != 1.5 || uncertain.StdDev() != 0.25 {
+		t.Errorf("Expected %v, received %v", []float64{1.5, 0.25}, []float64{uncertain.Mean(), uncertain.StdDev()})
+	}
+
+	if value.Real() != 1.5 || value.Complex() != 1.5 || value.Imag() != 0 {
+		t.Errorf("Expected %v, received %v", 1.5, value.Complex())
+	}
+
+	if value.String() != "(1.5±0.25)" {
+		t.Errorf("Expected %s, received %s", "(1.5±0.25)", value.String())
+	}
+
+	if value.IsZero() || !MakeUncertain(0, 0).IsZero() {
+		t.Errorf("Expected %t, received %t", false, value.IsZero())
+	}
+
+	if uncertain.Covariance(value) != 0.0625 || uncertain.Correlation(value) != 1 {
+		t.Errorf("Expected %v, received %v", []float64{0.0625, 1}, []float64{uncertain.Covariance(value), uncertain.Correlation(value)})
+	}
+
+	other := MakeUncertain(1.5, 0.25)
+	if uncertain.Covariance(other) != 0 || uncertain.Correlation(MakeValue(2)) != 0 {
+		t.Errorf("Expected independent measurements to be uncorrelated")
+	}
+
+	independent := MakeIndependent(value)
+	if mean, stdDev := UncertainParts(independent); mean != 1.5 || stdDev != 0.25 || uncertain.Covariance(independent) != 0 {
+		t.Errorf("Expected %v, received %v", value, independent)
+	}
+
+	if mean, stdDev := UncertainParts(MakeValue(3)); mean != 3 || stdDev != 0 {
+		t.Errorf("Expected %v, received %v", []float64{3, 0}, []float64{mean, stdDev})
+	}
+
+	if mean, _ := UncertainParts(MakeValue(1i)); !math.IsNaN(mean) {
+		t.Errorf("Expected %v, received %v", math.NaN(), mean)
+	}
+
+	values := MakeValues(1, value, MakeInterval(0, 1))
+	if values.Type() != Uncertain {
+		t.Errorf("Expected %v, received %v", Uncertain, values.Type())
+	}
+
+	if index := values.IndexOf(other); index != 1 {
+		t.Errorf("Expected %v, received %v", 1, index)
+	}
+}
+
+func TestPropagate(t *testing.T) {
+	x := MakeUncertain(2, 0.1)
+	y := MakeUncertain(3, 0.2)
+
+	sum := Propagate(5, []Value{x, y}, []float64{1, 1}).(UncertainValue)
+	if !closeTo(sum.StdDev(), math.Hypot(0.1, 0.2)) {
+		t.Errorf("Expected %v, received %v", math.Hypot(0.1, 0.2), sum.StdDev())
+	}
+
+	if !closeTo(sum.Covariance(x), 0.01) {
+		t.Errorf("Expected %v, received %v", 0.01, sum.Covariance(x))
+	}
+
+	difference := Propagate(0, []Value{x, x}, []float64{1, -1})
+	if !difference.IsZero() {
+		t.Errorf("Expected %v, received %v", 0, difference)
+	}
+}
+
+func TestMakeCorrelated(t *testing.T) {
+	covariance := [][]float64{{4, 2, 0}, {2, 2, 0}, {0, 0, 9}}
+	vals := MustMakeCorrelated([]float64{1, 2, 3}, covariance)
+	for i := range vals {
+		for j := range vals {
+			if received := vals[i].(UncertainValue).Covariance(vals[j]); !closeTo(received, covariance[i][j]) {
+				t.Errorf("Expected %v, received %v", covariance[i][j], received)
+			}
+		}
+	}
+
+	if correlation := vals[0].(UncertainValue).Correlation(vals[1]); !closeTo(correlation, 1/math.Sqrt2) {
+		t.Errorf("Expected %v, received %v", 1/math.Sqrt2, correlation)
+	}
+
+	perfect := MustMakeCorrelated([]float64{0, 0}, [][]float64{{1, 1}, {1, 1}})
+	if correlation := perfect[0].(UncertainValue).Correlation(perfect[1]); !closeTo(correlation, 1) {
+		t.Errorf("Expected %v, received %v", 1, correlation)
+	}
+
+	if _, err := MakeCorrelated([]float64{0, 0}, [][]float64{{1, 2}, {2, 1}}); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := MakeCorrelated([]float64{0, 0}, [][]float64{{1, 0.5}, {0, 1}}); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := MakeCorrelated([]float64{0, 0}, [][]float64{{1, 0}}); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic")
+		}
+	}()
+	MustMakeCorrelated([]float64{0}, [][]float64{{-1}})
+}
+
+func TestWeightedMeans(t *testing.T) {
+	vals := MakeValues(MakeUncertain(10, 1), MakeUncertain(12, 2))
+
+	mean := MustMean(vals).(UncertainValue)
+	if mean.Mean() != 11 || !closeTo(mean.StdDev(), math.Sqrt(5)/2) {
+		t.Errorf("Expected %v, received %v", []float64{11, math.Sqrt(5) / 2}, mean)
+	}
+
+	weighted := MustWeightedMean(vals, []float64{3, 1}).(UncertainValue)
+	if !closeTo(weighted.Mean(), 10.5) {
+		t.Errorf("Expected %v, received %v", 10.5, weighted.Mean())
+	}
+
+	best := MustInverseVarianceMean(vals).(UncertainValue)
+	if !closeTo(best.Mean(), 10.4) || !closeTo(best.StdDev(), 2/math.Sqrt(5)) {
+		t.Errorf("Expected %v, received %v", []float64{10.4, 2 / math.Sqrt(5)}, best)
+	}
+
+	if plain := MustMean(MakeValues(1, 2, 6)); plain.Real() != 3 || plain.(UncertainValue).StdDev() != 0 {
+		t.Errorf("Expected %v, received %v", 3, plain)
+	}
+
+	if _, err := Mean(NewValues(0)); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := WeightedMean(vals, []float64{1}); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := WeightedMean(vals, []float64{1, -1}); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := WeightedMean(vals, []float64{0, 0}); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := Mean(MakeValues(1, 1i)); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := InverseVarianceMean(MakeValues(MakeUncertain(1, 1), 2)); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic")
+		}
+	}()
+	MustInverseVarianceMean(MakeValues(1))
+}
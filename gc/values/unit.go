@@ -0,0 +1,263 @@
+package values
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Dimension holds the power of each SI base quantity, in the order
+// length, mass, time, electric current, temperature, amount of substance and luminous intensity
+type Dimension [7]int
+
+// baseSymbols are the SI base units of each entry of a Dimension
+var baseSymbols = [7]string{"m", "kg", "s", "A", "K", "mol", "cd"}
+
+// IsDimensionless returns true if every power of the Dimension is 0
+func (d Dimension) IsDimensionless() bool { return d == Dimension{} }
+
+// String returns the Dimension in SI base units, such as "kg m s^-2"
+func (d Dimension) String() string {
+	var parts []string
+	for index, power := range d {
+		switch power {
+		case 0:
+		case 1:
+			parts = append(parts, baseSymbols[index])
+		default:
+			parts = append(parts, fmt.Sprintf("%s^%d", baseSymbols[index], power))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// Unit is a multiplicative unit of measurement, Scale times the SI base units of its Dimension
+type Unit interface {
+	// returns the number of SI base units in one of the unit
+	Scale() float64
+
+	// returns the Dimension of the unit
+	Dimension() Dimension
+
+	// returns true if the unit has the same Dimension as u
+	Compatible(u Unit) bool
+
+	// returns the product of the unit and u
+	Mult(u Unit) Unit
+
+	// returns the quotient of the unit and u
+	Div(u Unit) Unit
+
+	// returns the unit raised to power. Returns error if a power of the Dimension would not be whole
+	Pow(power float64) (Unit, error)
+
+	// returns the symbol of the unit
+	String() string
+}
+
+type unit struct {
+	name      string
+	scale     float64
+	dimension Dimension
+}
+
+func (u *unit) Scale() float64 { return u.scale }
+
+func (u *unit) Dimension() Dimension { return u.dimension }
+
+func (u *unit) Compatible(other Unit) bool { return u.dimension == other.Dimension() }
+
+func (u *unit) Mult(other Unit) Unit {
+	var dimension Dimension
+	for index := range dimension {
+		dimension[index] = u.dimension[index] + other.Dimension()[index]
+	}
+	return MakeUnit(joinUnits(u.name, "*", other.String()), u.scale*other.Scale(), dimension)
+}
+
+func (u *unit) Div(other Unit) Unit {
+	var dimension Dimension
+	for index := range dimension {
+		dimension[index] = u.dimension[index] - other.Dimension()[index]
+	}
+	return MakeUnit(joinUnits(u.name, "/", other.String()), u.scale/other.Scale(), dimension)
+}
+
+func (u *unit) Pow(power float64) (Unit, error) {
+	var dimension Dimension
+	for index, exponent := range u.dimension {
+		raised := float64(exponent) * power
+		if raised != math.Trunc(raised) {
+			return nil, errors.New("Power of unit does not have a whole Dimension")
+		}
+		dimension[index] = int(raised)
+	}
+	if power == 1 {
+		return u, nil
+	}
+	name := u.name
+	if power == 0 {
+		name = ""
+	} else if name != "" {
+		name = fmt.Sprintf("%s^%s", parenthesize(name), strconv.FormatFloat(power, 'g', -1, 64))
+	}
+	return MakeUnit(name, math.Pow(u.scale, power), dimension), nil
+}
+
+func (u *unit) String() string { return u.name }
+
+// parenthesize wraps a compound unit symbol in parentheses
+func parenthesize(name string) string {
+	if strings.ContainsAny(name, "*/^") {
+		return "(" + name + ")"
+	}
+	return name
+}
+
+// joinUnits returns the symbol of two units joined by operator, leaving out dimensionless units
+func joinUnits(nameA, operator, nameB string) string {
+	switch {
+	case nameB == "":
+		return nameA
+	case nameA == "" && operator == "*":
+		return nameB
+	case nameA == "":
+		nameA = "1"
+	}
+	if operator == "/" {
+		nameB = parenthesize(nameB)
+	}
+	return nameA + operator + nameB
+}
+
+// MakeUnit returns a Unit with the symbol name that is scale times the SI base units of dimension
+func MakeUnit(name string, scale float64, dimension Dimension) Unit {
+	u := new(unit)
+	u.name = name
+	u.scale = scale
+	u.dimension = dimension
+	return u
+}
+
+// Dimensionless returns the Unit of a plain number
+func Dimensionless() Unit { return MakeUnit("", 1, Dimension{}) }
+
+// units are the symbols ParseUnit knows, before any prefix is applied
+var units = map[string]*unit{
+	"m":   {"m", 1, Dimension{1, 0, 0, 0, 0, 0, 0}},
+	"g":   {"g", 1e-3, Dimension{0, 1, 0, 0, 0, 0, 0}},
+	"s":   {"s", 1, Dimension{0, 0, 1, 0, 0, 0, 0}},
+	"A":   {"A", 1, Dimension{0, 0, 0, 1, 0, 0, 0}},
+	"K":   {"K", 1, Dimension{0, 0, 0, 0, 1, 0, 0}},
+	"mol": {"mol", 1, Dimension{0, 0, 0, 0, 0, 1, 0}},
+	"cd":  {"cd", 1, Dimension{0, 0, 0, 0, 0, 0, 1}},
+	"rad": {"rad", 1, Dimension{}},
+	"sr":  {"sr", 1, Dimension{}},
+	"Hz":  {"Hz", 1, Dimension{0, 0, -1, 0, 0, 0, 0}},
+	"N":   {"N", 1, Dimension{1, 1, -2, 0, 0, 0, 0}},
+	"Pa":  {"Pa", 1, Dimension{-1, 1, -2, 0, 0, 0, 0}},
+	"J":   {"J", 1, Dimension{2, 1, -2, 0, 0, 0, 0}},
+	"W":   {"W", 1, Dimension{2, 1, -3, 0, 0, 0, 0}},
+	"C":   {"C", 1, Dimension{0, 0, 1, 1, 0, 0, 0}},
+	"V":   {"V", 1, Dimension{2, 1, -3, -1, 0, 0, 0}},
+	"F":   {"F", 1, Dimension{-2, -1, 4, 2, 0, 0, 0}},
+	"ohm": {"ohm", 1, Dimension{2, 1, -3, -2, 0, 0, 0}},
+	"Ω":   {"Ω", 1, Dimension{2, 1, -3, -2, 0, 0, 0}},
+	"S":   {"S", 1, Dimension{-2, -1, 3, 2, 0, 0, 0}},
+	"Wb":  {"Wb", 1, Dimension{2, 1, -2, -1, 0, 0, 0}},
+	"T":   {"T", 1, Dimension{0, 1, -2, -1, 0, 0, 0}},
+	"H":   {"H", 1, Dimension{2, 1, -2, -2, 0, 0, 0}},
+	"L":   {"L", 1e-3, Dimension{3, 0, 0, 0, 0, 0, 0}},
+	"min": {"min", 60, Dimension{0, 0, 1, 0, 0, 0, 0}},
+	"h":   {"h", 3600, Dimension{0, 0, 1, 0, 0, 0, 0}},
+	"d":   {"d", 86400, Dimension{0, 0, 1, 0, 0, 0, 0}},
+	"eV":  {"eV", 1.602176634e-19, Dimension{2, 1, -2, 0, 0, 0, 0}},
+}
+
+// prefixes are the SI prefixes ParseUnit accepts, "da" is checked before "d"
+var prefixes = []struct {
+	symbol string
+	scale  float64
+}{
+	{"Y", 1e24}, {"Z", 1e21}, {"E", 1e18}, {"P", 1e15}, {"T", 1e12}, {"G", 1e9}, {"M", 1e6},
+	{"k", 1e3}, {"h", 1e2}, {"da", 1e1}, {"d", 1e-1}, {"c", 1e-2}, {"m", 1e-3}, {"u", 1e-6},
+	{"µ", 1e-6}, {"n", 1e-9}, {"p", 1e-12}, {"f", 1e-15}, {"a", 1e-18}, {"z", 1e-21}, {"y", 1e-24},
+}
+
+// parseSymbol returns the Unit of a single symbol, such as "km", with an optional prefix.
+// Symbols without a prefix are matched first, so "min" is minutes and "cd" is the candela
+func parseSymbol(symbol string) (Unit, error) {
+	if u, ok := units[symbol]; ok {
+		return u, nil
+	}
+	for _, prefix := range prefixes {
+		if !strings.HasPrefix(symbol, prefix.symbol) {
+			continue
+		}
+		if u, ok := units[strings.TrimPrefix(symbol, prefix.symbol)]; ok {
+			return MakeUnit(symbol, prefix.scale*u.scale, u.dimension), nil
+		}
+	}
+	return nil, fmt.Errorf("Unknown unit %q", symbol)
+}
+
+// ParseUnit returns the Unit described by s. s is a product of unit symbols, each with an optional
+// SI prefix and integer power, joined by "*", "/" or spaces, such as "km", "m/s^2" or "kg m^2 s^-2".
+// Everything after a "/" is in the denominator. An empty string or "1" is dimensionless
+func ParseUnit(s string) (Unit, error) {
+	s = strings.TrimSpace(s)
+	if s == "" || s == "1" {
+		return Dimensionless(), nil
+	}
+
+	result := Dimensionless()
+	denominator := false
+	fields := strings.Fields(strings.NewReplacer("*", " * ", "/", " / ").Replace(s))
+	for index, field := range fields {
+		switch field {
+		case "*":
+			continue
+		case "/":
+			if denominator || index == 0 || index == len(fields)-1 {
+				return nil, fmt.Errorf("Unit %q is not valid", s)
+			}
+			denominator = true
+			continue
+		case "1":
+			continue
+		}
+
+		symbol, power := field, 1.0
+		if caret := strings.Index(field, "^"); caret >= 0 {
+			exponent, err := strconv.Atoi(field[caret+1:])
+			if err != nil {
+				return nil, fmt.Errorf("Power in unit %q is not an integer", s)
+			}
+			symbol, power = field[:caret], float64(exponent)
+		}
+		u, err := parseSymbol(symbol)
+		if err != nil {
+			return nil, err
+		}
+		if denominator {
+			power = -power
+		}
+		u, err = u.Pow(power)
+		if err != nil {
+			return nil, err
+		}
+		result = result.Mult(u)
+	}
+	return MakeUnit(s, result.Scale(), result.Dimension()), nil
+}
+
+// MustParseUnit is the same as ParseUnit but will panic
+func MustParseUnit(s string) Unit {
+	u, err := ParseUnit(s)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
@@ -0,0 +1,146 @@
+package values
+
+import (
+	"math"
+	"testing"
+)
+
+func TestParseUnit(t *testing.T) {
+	testCases := []struct {
+		unit      string
+		scale     float64
+		dimension Dimension
+	}{
+		{"m", 1, Dimension{1, 0, 0, 0, 0, 0, 0}},
+		{"km", 1e3, Dimension{1, 0, 0, 0, 0, 0, 0}},
+		{"ms", 1e-3, Dimension{0, 0, 1, 0, 0, 0, 0}},
+		{"kg", 1, Dimension{0, 1, 0, 0, 0, 0, 0}},
+		{"min", 60, Dimension{0, 0, 1, 0, 0, 0, 0}},
+		{"mmol", 1e-3, Dimension{0, 0, 0, 0, 0, 1, 0}},
+		{"dam", 10, Dimension{1, 0, 0, 0, 0, 0, 0}},
+		{"cd", 1, Dimension{0, 0, 0, 0, 0, 0, 1}},
+		{"µs", 1e-6, Dimension{0, 0, 1, 0, 0, 0, 0}},
+		{"m/s^2", 1, Dimension{1, 0, -2, 0, 0, 0, 0}},
+		{"km/h", 1e3 / 3600, Dimension{1, 0, -1, 0, 0, 0, 0}},
+		{"kg m^2 s^-2", 1, Dimension{2, 1, -2, 0, 0, 0, 0}},
+		{"N*m", 1, Dimension{2, 1, -2, 0, 0, 0, 0}},
+		{"kPa", 1e3, Dimension{-1, 1, -2, 0, 0, 0, 0}},
+		{"1/s", 1, Dimension{0, 0, -1, 0, 0, 0, 0}},
+		{"cm^3", 1e-6, Dimension{3, 0, 0, 0, 0, 0, 0}},
+		{"", 1, Dimension{}},
+	}
+
+	for _, testCase := range testCases {
+		unit, err := ParseUnit(testCase.unit)
+		if err != nil {
+			t.Errorf("%s: Expected no error, received %v", testCase.unit, err)
+			continue
+		}
+		if math.Abs(unit.Scale()-testCase.scale) > 1e-12*testCase.scale {
+			t.Errorf("%s: Expected %v, received %v", testCase.unit, testCase.scale, unit.Scale())
+		}
+		if unit.Dimension() != testCase.dimension {
+			t.Errorf("%s: Expected %v, received %v", testCase.unit, testCase.dimension, unit.Dimension())
+		}
+		if unit.String() != testCase.unit {
+			t.Errorf("Expected %s, received %s", testCase.unit, unit.String())
+		}
+	}
+
+	for _, bad := range []string{"furlong", "m/", "/s", "m/s/s", "m^x", "m^1.5"} {
+		if _, err := ParseUnit(bad); err == nil {
+			t.Errorf("%s: Expected error", bad)
+		}
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic")
+		}
+	}()
+	MustParseUnit("parsec")
+}
+
+func TestUnitArithmetic(t *testing.T) {
+	metre, second := MustParseUnit("m"), MustParseUnit("s")
+	speed := metre.Div(second)
+	if speed.String() != "m/s" || speed.Dimension() != (Dimension{1, 0, -1, 0, 0, 0, 0}) {
+		t.Errorf("Expected %s, received %s", "m/s", speed)
+	}
+
+	if speed.Dimension().String() != "m s^-1" {
+		t.Errorf("Expected %s, received %s", "m s^-1", speed.Dimension().String())
+	}
+
+	area := MustParseUnit("km").Mult(MustParseUnit("km"))
+	if area.String() != "km*km" || area.Scale() != 1e6 {
+		t.Errorf("Expected %s, received %s", "km*km", area)
+	}
+
+	if per := Dimensionless().Div(speed); per.String() != "1/(m/s)" {
+		t.Errorf("Expected %s, received %s", "1/(m/s)", per)
+	}
+
+	side, err := area.Pow(0.5)
+	if err != nil || side.Dimension() != metre.Dimension() || math.Abs(side.Scale()-1e3) > 1e-9 {
+		t.Errorf("Expected %v, received %v", "km", side)
+	}
+
+	if _, err := metre.Pow(0.5); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if !metre.Compatible(MustParseUnit("km")) || metre.Compatible(second) {
+		t.Errorf("Expected m to be compatible with km and not s")
+	}
+
+	scale, err := ConvertScale(MustParseUnit("km/h"), MustParseUnit("m/s"))
+	if err != nil || math.Abs(scale-1/3.6) > 1e-15 {
+		t.Errorf("Expected %v, received %v", 1/3.6, scale)
+	}
+
+	if _, err := ConvertScale(metre, second); err == nil {
+		t.Errorf("Expected error")
+	}
+}
+
+func TestMakeQuantity(t *testing.T) {
+	value := MustMakeQuantity(5, "km")
+	if value.Type() != Quantity {
+		t.Errorf("Expected %v, received %v", Quantity, value.Type())
+	}
+
+	if value.Real() != 5 || value.String() != "5 km" {
+		t.Errorf("Expected %s, received %s", "5 km", value.String())
+	}
+
+	magnitude, unit := QuantityParts(value)
+	if magnitude.Real() != 5 || unit.String() != "km" {
+		t.Errorf("Expected %v, received %v", "5 km", []interface{}{magnitude, unit})
+	}
+
+	nested := MakeQuantityAlt(value, MustParseUnit("s"))
+	if nested.String() != "5 km*s" {
+		t.Errorf("Expected %s, received %s", "5 km*s", nested.String())
+	}
+
+	if _, unit := QuantityParts(MakeValue(2)); !unit.Dimension().IsDimensionless() {
+		t.Errorf("Expected a dimensionless unit, received %v", unit)
+	}
+
+	if _, err := MakeQuantity(1, "parsec"); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	values := MakeValues(1, value)
+	if values.Type() != Quantity || values.IndexOf(MustMakeQuantity(5, "km")) != 1 || values.IndexOf(MustMakeQuantity(5, "m")) != -1 {
+		t.Errorf("Expected %v to hold 5 km and not 5 m", values)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic")
+		}
+	}()
+	MustMakeQuantity(1, "m/")
+}
@@ -15,6 +15,14 @@ const (
 	Real Type = iota
 	// Complex is for a complex value
 	Complex
+	// Quaternion is for a quaternion value
+	Quaternion
+	// Interval is for a closed real interval value
+	Interval
+	// Uncertain is for a real value with a standard deviation
+	Uncertain
+	// Quantity is for a value with a unit of measurement
+	Quantity
 )
 
 // Value is the main return type for the GoCalculate Framework
@@ -101,17 +101,38 @@ func (v *values) Subset(start, finish int) Values {
 
 func (v *values) IndexOf(val Value) int {
 	for index, value := range v.values() {
-		if value != nil {
-			if value.Type() == Complex && value.Complex() == val.Complex() {
-				return index
-			} else if value.Real() == val.Real() {
-				return index
-			}
+		if value != nil && equal(value, val) {
+			return index
 		}
 	}
 	return -1
 }
 
+// equal returns true if value and val are the same, comparing all the parts of the higher ranking Type
+func equal(value Value, val Value) bool {
+	switch {
+	case value.Type() == Quantity || val.Type() == Quantity:
+		magnitude, unit := QuantityParts(value)
+		valMagnitude, valUnit := QuantityParts(val)
+		return unit.Compatible(valUnit) && unit.Scale() == valUnit.Scale() && equal(magnitude, valMagnitude)
+	case value.Type() == Uncertain || val.Type() == Uncertain:
+		mean, stdDev := UncertainParts(value)
+		valMean, valStdDev := UncertainParts(val)
+		return mean == valMean && stdDev == valStdDev
+	case value.Type() == Interval || val.Type() == Interval:
+		lower, upper := IntervalBounds(value)
+		valLower, valUpper := IntervalBounds(val)
+		return lower == valLower && upper == valUpper
+	case value.Type() == Quaternion || val.Type() == Quaternion:
+		w, x, y, z := QuaternionParts(value)
+		valW, valX, valY, valZ := QuaternionParts(val)
+		return w == valW && x == valX && y == valY && z == valZ
+	case value.Type() == Complex:
+		return value.Complex() == val.Complex()
+	}
+	return value.Real() == val.Real()
+}
+
 // NewValues will return a new Values
 // Type is Real
 func NewValues(length int) Values {
@@ -13,7 +13,7 @@ import (
 func SMult(scalar gcv.Value, vector v.Vector) v.Vector {
 	newVector := v.NewVector(vector.Space(), vector.Len())
 	for i := 0; i < vector.Len(); i++ {
-		newVector.Set(i, gcv.MakeValue(scalar.Complex()*vector.Get(i).Complex()))
+		newVector.Set(i, gcvops.Mult(scalar, vector.Get(i)))
 	}
 
 	return newVector
@@ -85,7 +85,29 @@ func OuterProduct(vectorA v.Vector, vectorB v.Vector) (m.Matrix, error) {
 	return matrix, nil
 }
 
-// Add adds two real v together
+// elementwise returns op of the elements of two Vectors of the same length and space.
+// If either Vector holds a Quantity quantityOp is used, so Values of different Dimensions return error
+func elementwise(vectorA v.Vector, vectorB v.Vector, op func(gcv.Value, gcv.Value) gcv.Value,
+	quantityOp func(gcv.Value, gcv.Value) (gcv.Value, error)) (v.Vector, error) {
+	vector := v.NewVector(vectorA.Space(), vectorA.Len())
+	quantity := vectorA.Type() == gcv.Quantity || vectorB.Type() == gcv.Quantity
+	for i := 0; i < vectorA.Len(); i++ {
+		if !quantity {
+			vector.Set(i, op(vectorA.Get(i), vectorB.Get(i)))
+			continue
+		}
+		value, err := quantityOp(vectorA.Get(i), vectorB.Get(i))
+		if err != nil {
+			return nil, err
+		}
+		vector.Set(i, value)
+	}
+
+	return vector, nil
+}
+
+// Add adds two real v together.
+// Returns error if the Vectors are not the same length and space or hold Quantities of different Dimensions
 func Add(vectorA v.Vector, vectorB v.Vector) (v.Vector, error) {
 	if vectorA.Space() != vectorB.Space() {
 		return nil, errors.New("Vectors are not of same type. Must be both be either column v or row v")
@@ -95,16 +117,11 @@ func Add(vectorA v.Vector, vectorB v.Vector) (v.Vector, error) {
 		return nil, errors.New("Vectors are not same dimensions")
 	}
 
-	vector := v.NewVector(vectorA.Space(), vectorA.Len())
-
-	for i := 0; i < vectorA.Len(); i++ {
-		vector.Set(i, gcvops.Add(vectorA.Get(i), vectorB.Get(i)))
-	}
-
-	return vector, nil
+	return elementwise(vectorA, vectorB, gcvops.Add, gcvops.AddQuantity)
 }
 
-// Sub subtracts two real v together
+// Sub subtracts two real v together.
+// Returns error if the Vectors are not the same length and space or hold Quantities of different Dimensions
 func Sub(vectorA v.Vector, vectorB v.Vector) (v.Vector, error) {
 	if vectorA.Space() != vectorB.Space() {
 		return nil, errors.New("Vectors are not of same type. Must be both be either column v or row v")
@@ -114,9 +131,21 @@ func Sub(vectorA v.Vector, vectorB v.Vector) (v.Vector, error) {
 		return nil, errors.New("Vectors are not same dimensions")
 	}
 
-	vector := v.NewVector(vectorA.Space(), vectorA.Len())
+	return elementwise(vectorA, vectorB, gcvops.Sub, gcvops.SubQuantity)
+}
+
+// Kron returns the Kronecker (tensor) product of two Vectors in the same space.
+// For Vectors of lengths n and p the result is of length np, in the same space
+func Kron(vectorA v.Vector, vectorB v.Vector) (v.Vector, error) {
+	if vectorA.Space() != vectorB.Space() {
+		return nil, errors.New("Vectors are not of same type. Must be both be either column v or row v")
+	}
+
+	vector := v.NewVector(vectorA.Space(), vectorA.Len()*vectorB.Len())
 	for i := 0; i < vectorA.Len(); i++ {
-		vector.Set(i, gcvops.Sub(vectorA.Get(i), vectorB.Get(i)))
+		for j := 0; j < vectorB.Len(); j++ {
+			vector.Set(i*vectorB.Len()+j, gcvops.Mult(vectorA.Get(i), vectorB.Get(j)))
+		}
 	}
 
 	return vector, nil
@@ -0,0 +1,169 @@
+package ops
+
+import (
+	"errors"
+
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	gcvops "github.com/NumberXNumbers/types/gc/values/ops"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+// dependenceTolerance is how small, relative to its starting norm, a vector may become after
+// removing its projection onto the others before it is taken to be linearly dependent on them
+const dependenceTolerance = 1e-10
+
+// conjDot returns the inner product sum conj(a_i)*b_i of two vectors of the same length,
+// which is the dot product for Real vectors
+func conjDot(vectorA v.Vector, vectorB v.Vector) gcv.Value {
+	product := gcv.Zero()
+	for i := 0; i < vectorA.Len(); i++ {
+		product = gcvops.Add(product, gcvops.Mult(gcvops.Conj(vectorA.Get(i)), vectorB.Get(i)))
+	}
+	return product
+}
+
+// checkVectors returns error if the vectors in vectors are not all of the same length
+func checkVectors(vectors v.Vectors) error {
+	for _, vector := range vectors.Vectors() {
+		if vector.Len() != vectors.InnerLen() {
+			return errors.New("Vectors are not same dimensions")
+		}
+	}
+	return nil
+}
+
+// orthogonalize removes from vector its projection onto each of the orthonormal vectors in basis,
+// one at a time as in modified Gram-Schmidt. The projection is removed twice, which is enough to
+// keep the result orthogonal to working precision. Returns the unit vector of the result and
+// false if vector is linearly dependent on basis
+func orthogonalize(basis []v.Vector, vector v.Vector) (v.Vector, bool) {
+	norm := MustPNorm(vector, 2).Real()
+	if norm == 0 {
+		return nil, false
+	}
+	for pass := 0; pass < 2; pass++ {
+		for _, unit := range basis {
+			vector = axpy(gcvops.Mult(gcv.MakeValue(-1), conjDot(unit, vector)), unit, vector)
+		}
+	}
+	remaining := MustPNorm(vector, 2)
+	if remaining.Real() <= dependenceTolerance*norm {
+		return nil, false
+	}
+	return SDiv(remaining, vector), true
+}
+
+// orthonormalize returns an orthonormal basis of the span of vectors and the indices of the
+// vectors that were not linearly dependent on the ones before them
+func orthonormalize(vectors []v.Vector) (basis []v.Vector, kept []int) {
+	for index, vector := range vectors {
+		if unit, ok := orthogonalize(basis, vector); ok {
+			basis = append(basis, unit)
+			kept = append(kept, index)
+		}
+	}
+	return basis, kept
+}
+
+// GramSchmidt returns an orthonormal basis of the span of vectors using modified Gram-Schmidt
+// with reorthogonalisation. Vectors that are linearly dependent on the ones before them are left out.
+// Returns error if the vectors are not all of the same length
+func GramSchmidt(vectors v.Vectors) (v.Vectors, error) {
+	if err := checkVectors(vectors); err != nil {
+		return nil, err
+	}
+	basis, _ := orthonormalize(vectors.Vectors())
+	return v.MakeVectorsAlt(vectors.Space(), basis), nil
+}
+
+// MustGramSchmidt is the same as GramSchmidt but will panic
+func MustGramSchmidt(vectors v.Vectors) v.Vectors {
+	basis, err := GramSchmidt(vectors)
+	if err != nil {
+		panic(err)
+	}
+	return basis
+}
+
+// IsLinearlyIndependent returns true if no vector in vectors is a linear combination of the others.
+// Returns error if the vectors are not all of the same length
+func IsLinearlyIndependent(vectors v.Vectors) (bool, error) {
+	if err := checkVectors(vectors); err != nil {
+		return false, err
+	}
+	_, kept := orthonormalize(vectors.Vectors())
+	return len(kept) == vectors.Len(), nil
+}
+
+// Basis returns the vectors of vectors that form a basis of their span, in their original order.
+// Each vector is kept unless it is a linear combination of the ones before it.
+// Returns error if the vectors are not all of the same length
+func Basis(vectors v.Vectors) (v.Vectors, error) {
+	if err := checkVectors(vectors); err != nil {
+		return nil, err
+	}
+	_, kept := orthonormalize(vectors.Vectors())
+	basis := make([]v.Vector, len(kept))
+	for index, vectorIndex := range kept {
+		basis[index] = vectors.Get(vectorIndex)
+	}
+	return v.MakeVectorsAlt(vectors.Space(), basis), nil
+}
+
+// MustBasis is the same as Basis but will panic
+func MustBasis(vectors v.Vectors) v.Vectors {
+	basis, err := Basis(vectors)
+	if err != nil {
+		panic(err)
+	}
+	return basis
+}
+
+// InSpan returns true if vector is a linear combination of the vectors in vectors.
+// Returns error if the vectors are not all of the same length and space as vector
+func InSpan(vectors v.Vectors, vector v.Vector) (bool, error) {
+	if err := checkVectors(vectors); err != nil {
+		return false, err
+	}
+	if vectors.Len() > 0 && vector.Len() != vectors.InnerLen() {
+		return false, errors.New("Length of vector does not match Vectors")
+	}
+	if vectors.Len() > 0 && vector.Space() != vectors.Space() {
+		return false, errors.New("Vector is not in the same space as Vectors")
+	}
+	basis, _ := orthonormalize(vectors.Vectors())
+	_, independent := orthogonalize(basis, vector)
+	return !independent, nil
+}
+
+// OrthogonalComplement returns an orthonormal basis of the vectors orthogonal to every vector in vectors.
+// Returns error if vectors is empty or the vectors are not all of the same length
+func OrthogonalComplement(vectors v.Vectors) (v.Vectors, error) {
+	if vectors.Len() == 0 {
+		return nil, errors.New("Vectors is empty")
+	}
+	if err := checkVectors(vectors); err != nil {
+		return nil, err
+	}
+
+	// extend the basis of the span with the standard basis, keeping only the new directions
+	basis, _ := orthonormalize(vectors.Vectors())
+	spanDimension := len(basis)
+	for i := 0; i < vectors.InnerLen() && len(basis) < vectors.InnerLen(); i++ {
+		standard := v.NewVector(vectors.Space(), vectors.InnerLen())
+		standard.Set(i, gcv.MakeValue(1))
+		if unit, ok := orthogonalize(basis, standard); ok {
+			basis = append(basis, unit)
+		}
+	}
+	return v.MakeVectorsAlt(vectors.Space(), basis[spanDimension:]), nil
+}
+
+// MustOrthogonalComplement is the same as OrthogonalComplement but will panic
+func MustOrthogonalComplement(vectors v.Vectors) v.Vectors {
+	complement, err := OrthogonalComplement(vectors)
+	if err != nil {
+		panic(err)
+	}
+	return complement
+}
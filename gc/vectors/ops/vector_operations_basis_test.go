@@ -0,0 +1,152 @@
+package ops
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+// checkOrthonormal fails unless the vectors are orthonormal under the conjugate inner product
+func checkOrthonormal(t *testing.T, vectors v.Vectors) {
+	for i := 0; i < vectors.Len(); i++ {
+		for j := 0; j < vectors.Len(); j++ {
+			expected := 0.0
+			if i == j {
+				expected = 1
+			}
+			if product := conjDot(vectors.Get(i), vectors.Get(j)).Complex(); cmplx.Abs(product-complex(expected, 0)) > 1e-12 {
+				t.Errorf("Expected <%d, %d> = %v, received %v", i, j, expected, product)
+			}
+		}
+	}
+}
+
+func TestGramSchmidt(t *testing.T) {
+	vectors := v.MakeVectors(v.ColSpace,
+		v.MakeVector(v.ColSpace, 1, 1, 0),
+		v.MakeVector(v.ColSpace, 2, 2, 0),
+		v.MakeVector(v.ColSpace, 1, 0, 1))
+	basis := MustGramSchmidt(vectors)
+	if basis.Len() != 2 || basis.Space() != v.ColSpace {
+		t.Fatalf("Expected %v vectors, received %v", 2, basis.Len())
+	}
+	checkOrthonormal(t, basis)
+	if first := basis.Get(0); math.Abs(first.Get(0).Real()-1/math.Sqrt2) > 1e-12 || first.Get(2).Real() != 0 {
+		t.Errorf("Expected %v, received %v", []float64{1 / math.Sqrt2, 1 / math.Sqrt2, 0}, first.Elements())
+	}
+
+	// nearly parallel vectors lose orthogonality with a single pass of classical Gram-Schmidt
+	epsilon := 1e-8
+	illConditioned := v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 1, epsilon, 0, 0),
+		v.MakeVector(v.RowSpace, 1, 0, epsilon, 0),
+		v.MakeVector(v.RowSpace, 1, 0, 0, epsilon))
+	basis = MustGramSchmidt(illConditioned)
+	if basis.Len() != 3 {
+		t.Fatalf("Expected %v vectors, received %v", 3, basis.Len())
+	}
+	checkOrthonormal(t, basis)
+
+	complexVectors := v.MakeVectors(v.ColSpace, v.MakeVector(v.ColSpace, 1, 1i), v.MakeVector(v.ColSpace, 1i, 2))
+	basis = MustGramSchmidt(complexVectors)
+	if basis.Len() != 2 {
+		t.Fatalf("Expected %v vectors, received %v", 2, basis.Len())
+	}
+	checkOrthonormal(t, basis)
+
+	uneven := v.MakeVectors(v.ColSpace, v.MakeVector(v.ColSpace, 1, 1), v.MakeVector(v.ColSpace, 1, 0, 1))
+	if _, err := GramSchmidt(uneven); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic")
+		}
+	}()
+	MustGramSchmidt(uneven)
+}
+
+func TestBasisUtilities(t *testing.T) {
+	vectors := v.MakeVectors(v.ColSpace,
+		v.MakeVector(v.ColSpace, 1, 0, 1),
+		v.MakeVector(v.ColSpace, 0, 1, 1),
+		v.MakeVector(v.ColSpace, 1, 1, 2))
+
+	if independent, err := IsLinearlyIndependent(vectors); err != nil || independent {
+		t.Errorf("Expected %t, received %t", false, independent)
+	}
+
+	if independent, _ := IsLinearlyIndependent(vectors.Subset(0, 1)); !independent {
+		t.Errorf("Expected %t, received %t", true, independent)
+	}
+
+	basis := MustBasis(vectors)
+	if basis.Len() != 2 || basis.IndexOf(vectors.Get(0)) != 0 || basis.IndexOf(vectors.Get(1)) != 1 {
+		t.Errorf("Expected the first two vectors, received %v", basis.Len())
+	}
+
+	if in, err := InSpan(vectors, v.MakeVector(v.ColSpace, 2, -3, -1)); err != nil || !in {
+		t.Errorf("Expected %t, received %t", true, in)
+	}
+
+	if in, _ := InSpan(vectors, v.MakeVector(v.ColSpace, 0, 0, 1)); in {
+		t.Errorf("Expected %t, received %t", false, in)
+	}
+
+	if in, _ := InSpan(vectors, v.NewVector(v.ColSpace, 3)); !in {
+		t.Errorf("Expected the zero vector to be in every span")
+	}
+
+	if _, err := InSpan(vectors, v.MakeVector(v.ColSpace, 1, 0)); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := InSpan(vectors, v.MakeVector(v.RowSpace, 1, 0, 1)); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	complement := MustOrthogonalComplement(vectors)
+	if complement.Len() != 1 {
+		t.Fatalf("Expected %v vectors, received %v", 1, complement.Len())
+	}
+	checkOrthonormal(t, complement)
+	for _, vector := range vectors.Vectors() {
+		if product := conjDot(vector, complement.Get(0)); math.Abs(product.Real()) > 1e-12 {
+			t.Errorf("Expected %v, received %v", 0, product)
+		}
+	}
+
+	line := v.MakeVectors(v.RowSpace, v.MakeVector(v.RowSpace, 1, 2, 3, 4))
+	complement = MustOrthogonalComplement(line)
+	if complement.Len() != 3 || complement.Space() != v.RowSpace {
+		t.Errorf("Expected %v vectors, received %v", 3, complement.Len())
+	}
+	checkOrthonormal(t, complement)
+
+	if _, err := OrthogonalComplement(v.MakeVectors(v.ColSpace)); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	uneven := v.MakeVectors(v.ColSpace, v.MakeVector(v.ColSpace, 1, 1), v.MakeVector(v.ColSpace, 1, 0, 1))
+	if _, err := IsLinearlyIndependent(uneven); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := Basis(uneven); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := OrthogonalComplement(uneven); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic")
+		}
+	}()
+	MustOrthogonalComplement(uneven)
+}
@@ -0,0 +1,135 @@
+package ops
+
+import (
+	"errors"
+
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	gcvops "github.com/NumberXNumbers/types/gc/values/ops"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+// checkReal returns error if the vectors are not all Real, in the same space and of the same length
+func checkReal(vectors ...v.Vector) error {
+	for _, vector := range vectors {
+		if vector.Type() != gcv.Real {
+			return errors.New("Vectors must only hold Real Values")
+		}
+	}
+	for _, vector := range vectors[1:] {
+		if vector.Space() != vectors[0].Space() {
+			return errors.New("Vectors are not of same type. Must be both be either column v or row v")
+		}
+		if vector.Len() != vectors[0].Len() {
+			return errors.New("Vectors are not same dimensions")
+		}
+	}
+	return nil
+}
+
+// check3D returns error if the vectors are not all Real, of length 3 and in the same space
+func check3D(vectors ...v.Vector) error {
+	if vectors[0].Len() != 3 {
+		return errors.New("Vectors are not of length 3")
+	}
+	return checkReal(vectors...)
+}
+
+// cross returns the cross product of two vectors of length 3
+func cross(vectorA v.Vector, vectorB v.Vector) v.Vector {
+	vector := v.NewVector(vectorA.Space(), 3)
+	for i := 0; i < 3; i++ {
+		j, k := (i+1)%3, (i+2)%3
+		vector.Set(i, gcvops.Sub(gcvops.Mult(vectorA.Get(j), vectorB.Get(k)), gcvops.Mult(vectorA.Get(k), vectorB.Get(j))))
+	}
+	return vector
+}
+
+// axpy returns scalar*vectorA + vectorB for vectors of the same length and space
+func axpy(scalar gcv.Value, vectorA v.Vector, vectorB v.Vector) v.Vector {
+	vector := v.NewVector(vectorB.Space(), vectorB.Len())
+	for i := 0; i < vectorB.Len(); i++ {
+		vector.Set(i, gcvops.Add(gcvops.Mult(scalar, vectorA.Get(i)), vectorB.Get(i)))
+	}
+	return vector
+}
+
+// Cross returns the cross product vectorA x vectorB of two real Vectors of length 3.
+// Both Vectors must be in the same space, which the result is also in, else error is returned
+func Cross(vectorA v.Vector, vectorB v.Vector) (v.Vector, error) {
+	if err := check3D(vectorA, vectorB); err != nil {
+		return nil, err
+	}
+	return cross(vectorA, vectorB), nil
+}
+
+// ScalarTripleProduct returns vectorA . (vectorB x vectorC), the signed volume of the
+// parallelepiped of three real Vectors of length 3 in the same space
+func ScalarTripleProduct(vectorA v.Vector, vectorB v.Vector, vectorC v.Vector) (gcv.Value, error) {
+	if err := check3D(vectorA, vectorB, vectorC); err != nil {
+		return nil, err
+	}
+	return conjDot(vectorA, cross(vectorB, vectorC)), nil
+}
+
+// VectorTripleProduct returns vectorA x (vectorB x vectorC) of three real Vectors of length 3 in the same space
+func VectorTripleProduct(vectorA v.Vector, vectorB v.Vector, vectorC v.Vector) (v.Vector, error) {
+	if err := check3D(vectorA, vectorB, vectorC); err != nil {
+		return nil, err
+	}
+	return cross(vectorA, cross(vectorB, vectorC)), nil
+}
+
+// Project returns the projection of real Vector vectorA onto vectorB.
+// Returns error if the Vectors are not Real, the same length and space or vectorB is the zero vector
+func Project(vectorA v.Vector, vectorB v.Vector) (v.Vector, error) {
+	if err := checkReal(vectorA, vectorB); err != nil {
+		return nil, err
+	}
+	normSq := conjDot(vectorB, vectorB)
+	if normSq.IsZero() {
+		return nil, errors.New("Cannot project onto the zero vector")
+	}
+	return SMult(gcvops.Div(conjDot(vectorA, vectorB), normSq), vectorB), nil
+}
+
+// Reject returns the rejection of real Vector vectorA from vectorB, the part of vectorA
+// orthogonal to vectorB. Returns error if the Vectors are not Real, the same length and space or
+// vectorB is the zero vector
+func Reject(vectorA v.Vector, vectorB v.Vector) (v.Vector, error) {
+	projection, err := Project(vectorA, vectorB)
+	if err != nil {
+		return nil, err
+	}
+	return axpy(gcv.MakeValue(-1), projection, vectorA), nil
+}
+
+// Reflect returns the reflection of real Vector vector across the plane through the origin with normal Vector normal.
+// Returns error if the Vectors are not Real, the same length and space or normal is the zero vector
+func Reflect(vector v.Vector, normal v.Vector) (v.Vector, error) {
+	projection, err := Project(vector, normal)
+	if err != nil {
+		return nil, err
+	}
+	return axpy(gcv.MakeValue(-2), projection, vector), nil
+}
+
+// Rotate returns real Vector vector of length 3 rotated by angle, in radians, about axis using
+// Rodrigues' rotation formula. The rotation is counterclockwise looking down axis towards the origin.
+// Returns error if the Vectors are not Real, of length 3 and in the same space or axis is the zero vector
+func Rotate(vector v.Vector, axis v.Vector, angle gcv.Value) (v.Vector, error) {
+	if err := check3D(vector, axis); err != nil {
+		return nil, err
+	}
+	norm := gcvops.Sqrt(conjDot(axis, axis))
+	if norm.IsZero() {
+		return nil, errors.New("Cannot rotate about the zero vector")
+	}
+	unit := SDiv(norm, axis)
+
+	// v cos(angle) + (k x v) sin(angle) + k (k . v) (1 - cos(angle))
+	cos, sin := gcvops.Cos(angle), gcvops.Sin(angle)
+	rotated := SMult(cos, vector)
+	rotated = axpy(sin, cross(unit, vector), rotated)
+	rotated = axpy(gcvops.Mult(conjDot(unit, vector), gcvops.Sub(gcv.MakeValue(1), cos)), unit, rotated)
+	return rotated, nil
+}
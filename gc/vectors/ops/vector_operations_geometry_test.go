@@ -0,0 +1,162 @@
+package ops
+
+import (
+	"math"
+	"testing"
+
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+// vectorsClose returns true if two vectors are in the same space with elements within 1e-12
+func vectorsClose(vectorA v.Vector, vectorB v.Vector) bool {
+	if vectorA.Space() != vectorB.Space() || vectorA.Len() != vectorB.Len() {
+		return false
+	}
+	for i := 0; i < vectorA.Len(); i++ {
+		if math.Abs(vectorA.Get(i).Real()-vectorB.Get(i).Real()) > 1e-12 {
+			return false
+		}
+	}
+	return true
+}
+
+func TestCross(t *testing.T) {
+	x := v.MakeVector(v.ColSpace, 1, 0, 0)
+	y := v.MakeVector(v.ColSpace, 0, 1, 0)
+	z := v.MakeVector(v.ColSpace, 0, 0, 1)
+
+	result, err := Cross(x, y)
+	if err != nil || !vectorsClose(result, z) {
+		t.Errorf("Expected %v, received %v", z.Elements(), result)
+	}
+
+	result, _ = Cross(v.MakeVector(v.RowSpace, 1, 2, 3), v.MakeVector(v.RowSpace, 4, 5, 6))
+	solution := v.MakeVector(v.RowSpace, -3, 6, -3)
+	if !vectorsClose(result, solution) {
+		t.Errorf("Expected %v, received %v", solution.Elements(), result.Elements())
+	}
+
+	if _, err := Cross(v.MakeVector(v.ColSpace, 1, 2), v.MakeVector(v.ColSpace, 3, 4)); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := Cross(x, v.MakeVector(v.RowSpace, 0, 1, 0)); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := Cross(x, v.MakeVector(v.ColSpace, 0, 1, 0, 0)); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := Cross(x, v.MakeVector(v.ColSpace, 0, 1i, 0)); err == nil {
+		t.Errorf("Expected error for Complex Vector")
+	}
+}
+
+func TestTripleProducts(t *testing.T) {
+	a := v.MakeVector(v.ColSpace, 1, 2, 3)
+	b := v.MakeVector(v.ColSpace, -1, 0, 2)
+	c := v.MakeVector(v.ColSpace, 4, 1, 1)
+
+	volume, err := ScalarTripleProduct(a, b, c)
+	if err != nil || volume.Real() != 13 {
+		t.Errorf("Expected %v, received %v", 13, volume)
+	}
+
+	// a x (b x c) = b(a.c) - c(a.b)
+	result, err := VectorTripleProduct(a, b, c)
+	solution := v.MakeVector(v.ColSpace, -1*9-4*5, 0*9-1*5, 2*9-1*5)
+	if err != nil || !vectorsClose(result, solution) {
+		t.Errorf("Expected %v, received %v", solution.Elements(), result)
+	}
+
+	if _, err := ScalarTripleProduct(a, b, v.MakeVector(v.RowSpace, 4, 1, 1)); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := VectorTripleProduct(a, b, v.MakeVector(v.ColSpace, 4, 1)); err == nil {
+		t.Errorf("Expected error")
+	}
+}
+
+func TestProjectRejectReflect(t *testing.T) {
+	a := v.MakeVector(v.ColSpace, 3, 4, 5)
+	b := v.MakeVector(v.ColSpace, 0, 0, 2)
+
+	projection, err := Project(a, b)
+	if err != nil || !vectorsClose(projection, v.MakeVector(v.ColSpace, 0, 0, 5)) {
+		t.Errorf("Expected %v, received %v", []float64{0, 0, 5}, projection)
+	}
+
+	rejection, err := Reject(a, b)
+	if err != nil || !vectorsClose(rejection, v.MakeVector(v.ColSpace, 3, 4, 0)) {
+		t.Errorf("Expected %v, received %v", []float64{3, 4, 0}, rejection)
+	}
+
+	reflection, err := Reflect(a, b)
+	if err != nil || !vectorsClose(reflection, v.MakeVector(v.ColSpace, 3, 4, -5)) {
+		t.Errorf("Expected %v, received %v", []float64{3, 4, -5}, reflection)
+	}
+
+	// projection works in any number of dimensions
+	projection, _ = Project(v.MakeVector(v.RowSpace, 1, 3), v.MakeVector(v.RowSpace, 1, 1))
+	if !vectorsClose(projection, v.MakeVector(v.RowSpace, 2, 2)) {
+		t.Errorf("Expected %v, received %v", []float64{2, 2}, projection)
+	}
+
+	if _, err := Project(a, v.NewVector(v.ColSpace, 3)); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := Reject(a, v.MakeVector(v.RowSpace, 0, 0, 2)); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := Reflect(a, v.MakeVector(v.ColSpace, 0, 2)); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	complexVector := v.MakeVector(v.ColSpace, 1i, 0, 1)
+	if _, err := Project(complexVector, b); err == nil {
+		t.Errorf("Expected error for Complex Vector")
+	}
+
+	if _, err := Reject(a, complexVector); err == nil {
+		t.Errorf("Expected error for Complex Vector")
+	}
+}
+
+func TestRotate(t *testing.T) {
+	x := v.MakeVector(v.ColSpace, 1, 0, 0)
+	axis := v.MakeVector(v.ColSpace, 0, 0, 5)
+
+	result, err := Rotate(x, axis, gcv.MakeValue(math.Pi/2))
+	if err != nil || !vectorsClose(result, v.MakeVector(v.ColSpace, 0, 1, 0)) {
+		t.Errorf("Expected %v, received %v", []float64{0, 1, 0}, result)
+	}
+
+	// a third of a turn about (1, 1, 1) cycles the axes
+	result, _ = Rotate(x, v.MakeVector(v.ColSpace, 1, 1, 1), gcv.MakeValue(2*math.Pi/3))
+	if !vectorsClose(result, v.MakeVector(v.ColSpace, 0, 1, 0)) {
+		t.Errorf("Expected %v, received %v", []float64{0, 1, 0}, result.Elements())
+	}
+
+	// the part along the axis is unchanged
+	result, _ = Rotate(v.MakeVector(v.ColSpace, 1, 0, 2), axis, gcv.MakeValue(math.Pi))
+	if !vectorsClose(result, v.MakeVector(v.ColSpace, -1, 0, 2)) {
+		t.Errorf("Expected %v, received %v", []float64{-1, 0, 2}, result.Elements())
+	}
+
+	if _, err := Rotate(x, v.NewVector(v.ColSpace, 3), gcv.MakeValue(1)); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := Rotate(x, v.MakeVector(v.RowSpace, 0, 0, 1), gcv.MakeValue(1)); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := Rotate(v.MakeVector(v.ColSpace, 1, 0), v.MakeVector(v.ColSpace, 0, 1), gcv.MakeValue(1)); err == nil {
+		t.Errorf("Expected error")
+	}
+}
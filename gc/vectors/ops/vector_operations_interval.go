@@ -0,0 +1,69 @@
+package ops
+
+import (
+	"errors"
+	"math"
+
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+// MakeIntervalVector returns a Vector of Interval Values from the Real Vectors lower and upper.
+// Returns error if lower and upper do not match in length and space or are not Real
+func MakeIntervalVector(lower v.Vector, upper v.Vector) (v.Vector, error) {
+	if lower.Len() != upper.Len() {
+		return nil, errors.New("Length of Vectors does not match")
+	}
+	if lower.Space() != upper.Space() {
+		return nil, errors.New("Vectors are not in the same space")
+	}
+	if lower.Type() != gcv.Real || upper.Type() != gcv.Real {
+		return nil, errors.New("Vectors are not Real")
+	}
+
+	vector := v.NewVector(lower.Space(), lower.Len())
+	for i := 0; i < lower.Len(); i++ {
+		vector.Set(i, gcv.MakeInterval(lower.Get(i).Real(), upper.Get(i).Real()))
+	}
+	return vector, nil
+}
+
+// MustMakeIntervalVector is the same as MakeIntervalVector but will panic
+func MustMakeIntervalVector(lower v.Vector, upper v.Vector) v.Vector {
+	vector, err := MakeIntervalVector(lower, upper)
+	if err != nil {
+		panic(err)
+	}
+	return vector
+}
+
+// IntervalBounds returns the Real Vectors of the lower and upper bounds of each element of vector
+func IntervalBounds(vector v.Vector) (lower v.Vector, upper v.Vector) {
+	lower = v.NewVector(vector.Space(), vector.Len())
+	upper = v.NewVector(vector.Space(), vector.Len())
+	for i := 0; i < vector.Len(); i++ {
+		lowerBound, upperBound := gcv.IntervalBounds(vector.Get(i))
+		lower.Set(i, gcv.MakeValue(lowerBound))
+		upper.Set(i, gcv.MakeValue(upperBound))
+	}
+	return lower, upper
+}
+
+// IntervalMidpoint returns the Real Vector of the midpoints of each element of vector
+func IntervalMidpoint(vector v.Vector) v.Vector {
+	midpoint := v.NewVector(vector.Space(), vector.Len())
+	for i := 0; i < vector.Len(); i++ {
+		midpoint.Set(i, gcv.MakeValue(vector.Get(i).Real()))
+	}
+	return midpoint
+}
+
+// IntervalWidth returns the largest width of the elements of vector
+func IntervalWidth(vector v.Vector) float64 {
+	width := 0.0
+	for i := 0; i < vector.Len(); i++ {
+		lower, upper := gcv.IntervalBounds(vector.Get(i))
+		width = math.Max(width, upper-lower)
+	}
+	return width
+}
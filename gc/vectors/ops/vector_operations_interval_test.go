@@ -0,0 +1,62 @@
+package ops
+
+import (
+	"testing"
+
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+func TestIntervalVector(t *testing.T) {
+	lower := v.MakeVector(v.ColSpace, 1, -2, 3)
+	upper := v.MakeVector(v.ColSpace, 2, -1, 3)
+	vector := MustMakeIntervalVector(lower, upper)
+	if vector.Type() != gcv.Interval {
+		t.Errorf("Expected %v, received %v", gcv.Interval, vector.Type())
+	}
+
+	if vector.Get(1).String() != "[-2, -1]" {
+		t.Errorf("Expected %s, received %s", "[-2, -1]", vector.Get(1))
+	}
+
+	if width := IntervalWidth(vector); width != 1 {
+		t.Errorf("Expected %v, received %v", 1, width)
+	}
+
+	midpoint := IntervalMidpoint(vector)
+	if midpoint.Type() != gcv.Real || midpoint.Get(0).Real() != 1.5 || midpoint.Get(2).Real() != 3 {
+		t.Errorf("Expected %v, received %v", []float64{1.5, -1.5, 3}, midpoint.Elements())
+	}
+
+	lowerBounds, upperBounds := IntervalBounds(vector)
+	for i := 0; i < vector.Len(); i++ {
+		if lowerBounds.Get(i).Real() != lower.Get(i).Real() || upperBounds.Get(i).Real() != upper.Get(i).Real() {
+			t.Errorf("Expected %v, received %v", vector.Get(i), []gcv.Value{lowerBounds.Get(i), upperBounds.Get(i)})
+		}
+	}
+
+	// scaling keeps the Interval Values
+	scaled := SMult(gcv.MakeValue(2), vector)
+	if !scaled.Get(0).(gcv.IntervalValue).Contains(gcv.MakeInterval(2, 4)) || IntervalWidth(scaled) > 2+1e-12 {
+		t.Errorf("Expected %s, received %s", "[2, 4]", scaled.Get(0))
+	}
+
+	if _, err := MakeIntervalVector(lower, v.MakeVector(v.ColSpace, 1, 2)); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := MakeIntervalVector(lower, v.MakeVector(v.RowSpace, 2, -1, 3)); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := MakeIntervalVector(lower, v.MakeVector(v.ColSpace, 2, 1i, 3)); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic")
+		}
+	}()
+	MustMakeIntervalVector(lower, v.MakeVector(v.ColSpace, 1, 2))
+}
@@ -0,0 +1,111 @@
+package ops
+
+import (
+	"errors"
+	"math"
+
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	gcvops "github.com/NumberXNumbers/types/gc/values/ops"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+// scaledNorm returns (sum weights[i]*magnitudes[i]^p)^(1/p). Every magnitude is divided by the
+// largest first, so the sum can not overflow or underflow. A nil weights weighs every element as 1
+func scaledNorm(magnitudes []float64, weights []float64, p float64) float64 {
+	weight := func(i int) float64 {
+		if weights == nil {
+			return 1
+		}
+		return weights[i]
+	}
+
+	scale := 0.0
+	for i, magnitude := range magnitudes {
+		if weight(i) > 0 {
+			scale = math.Max(scale, magnitude)
+		}
+	}
+	if scale == 0 || math.IsInf(p, 1) || math.IsInf(scale, 1) {
+		return scale
+	}
+
+	sum := 0.0
+	for i, magnitude := range magnitudes {
+		sum += weight(i) * math.Pow(magnitude/scale, p)
+	}
+	return scale * math.Pow(sum, 1/p)
+}
+
+// magnitudes returns the absolute value of each element of vector
+func magnitudes(vector v.Vector) []float64 {
+	result := make([]float64, vector.Len())
+	for i := range result {
+		result[i] = gcvops.Abs(vector.Get(i)).Real()
+	}
+	return result
+}
+
+// checkP returns error if p is not a valid order for a norm
+func checkP(p float64) error {
+	if math.IsNaN(p) || p < 1 {
+		return errors.New("p must be at least 1")
+	}
+	return nil
+}
+
+// PNorm returns the p-norm (sum |x_i|^p)^(1/p) of vector. p may be math.Inf(1) for the infinity norm.
+// Returns error if p is less than 1
+func PNorm(vector v.Vector, p float64) (gcv.Value, error) {
+	if err := checkP(p); err != nil {
+		return nil, err
+	}
+	return gcv.MakeValue(scaledNorm(magnitudes(vector), nil, p)), nil
+}
+
+// MustPNorm is the same as PNorm but will panic
+func MustPNorm(vector v.Vector, p float64) gcv.Value {
+	norm, err := PNorm(vector, p)
+	if err != nil {
+		panic(err)
+	}
+	return norm
+}
+
+// InfNorm returns the infinity norm of vector, the largest absolute value of its elements
+func InfNorm(vector v.Vector) gcv.Value {
+	return gcv.MakeValue(scaledNorm(magnitudes(vector), nil, math.Inf(1)))
+}
+
+// WeightedNorm returns the weighted p-norm (sum w_i |x_i|^p)^(1/p) of vector. For p of
+// math.Inf(1) this is the largest absolute value of the elements with a positive weight.
+// Returns error if p is less than 1, or weights is not a Real Vector of non negative weights
+// of the same length as vector
+func WeightedNorm(vector v.Vector, weights v.Vector, p float64) (gcv.Value, error) {
+	if err := checkP(p); err != nil {
+		return nil, err
+	}
+	if vector.Len() != weights.Len() {
+		return nil, errors.New("Length of weights does not match")
+	}
+	if weights.Type() != gcv.Real {
+		return nil, errors.New("Weights are not Real")
+	}
+
+	weightValues := make([]float64, weights.Len())
+	for i := range weightValues {
+		weightValues[i] = weights.Get(i).Real()
+		if weightValues[i] < 0 {
+			return nil, errors.New("Weights must not be negative")
+		}
+	}
+	return gcv.MakeValue(scaledNorm(magnitudes(vector), weightValues, p)), nil
+}
+
+// MustWeightedNorm is the same as WeightedNorm but will panic
+func MustWeightedNorm(vector v.Vector, weights v.Vector, p float64) gcv.Value {
+	norm, err := WeightedNorm(vector, weights, p)
+	if err != nil {
+		panic(err)
+	}
+	return norm
+}
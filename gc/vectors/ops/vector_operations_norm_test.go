@@ -0,0 +1,97 @@
+package ops
+
+import (
+	"math"
+	"testing"
+
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+func TestPNorm(t *testing.T) {
+	vector := v.MakeVector(v.ColSpace, 3, -4, 0)
+	testCases := []struct {
+		p        float64
+		solution float64
+	}{
+		{1, 7},
+		{2, 5},
+		{3, math.Cbrt(91)},
+		{math.Inf(1), 4},
+	}
+	for _, testCase := range testCases {
+		norm := MustPNorm(vector, testCase.p)
+		if math.Abs(norm.Real()-testCase.solution) > 1e-12 {
+			t.Errorf("p = %v: Expected %v, received %v", testCase.p, testCase.solution, norm)
+		}
+	}
+
+	if norm := InfNorm(vector); norm.Real() != 4 {
+		t.Errorf("Expected %v, received %v", 4, norm)
+	}
+
+	if norm := MustPNorm(v.MakeVector(v.RowSpace, 3i, 4), 2); math.Abs(norm.Real()-5) > 1e-12 {
+		t.Errorf("Expected %v, received %v", 5, norm)
+	}
+
+	// the squares of these elements overflow float64 without scaling
+	huge := v.MakeVector(v.ColSpace, 3e200, 4e200)
+	if norm := MustPNorm(huge, 2); math.Abs(norm.Real()/5e200-1) > 1e-12 {
+		t.Errorf("Expected %v, received %v", 5e200, norm)
+	}
+
+	tiny := v.MakeVector(v.ColSpace, 3e-200, 4e-200)
+	if norm := MustPNorm(tiny, 2); math.Abs(norm.Real()/5e-200-1) > 1e-12 {
+		t.Errorf("Expected %v, received %v", 5e-200, norm)
+	}
+
+	if norm := MustPNorm(v.NewVector(v.ColSpace, 2), 2); !norm.IsZero() {
+		t.Errorf("Expected %v, received %v", 0, norm)
+	}
+
+	if _, err := PNorm(vector, 0.5); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic")
+		}
+	}()
+	MustPNorm(vector, math.NaN())
+}
+
+func TestWeightedNorm(t *testing.T) {
+	vector := v.MakeVector(v.ColSpace, 3, -4, 10)
+	weights := v.MakeVector(v.ColSpace, 4, 1, 0)
+
+	if norm := MustWeightedNorm(vector, weights, 2); math.Abs(norm.Real()-math.Sqrt(52)) > 1e-12 {
+		t.Errorf("Expected %v, received %v", math.Sqrt(52), norm)
+	}
+
+	if norm := MustWeightedNorm(vector, weights, math.Inf(1)); norm.Real() != 4 {
+		t.Errorf("Expected %v, received %v", 4, norm)
+	}
+
+	if _, err := WeightedNorm(vector, v.MakeVector(v.ColSpace, 1, 1), 2); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := WeightedNorm(vector, v.MakeVector(v.ColSpace, 1, -1, 1), 2); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := WeightedNorm(vector, v.MakeVector(v.ColSpace, 1, 1i, 1), 2); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	if _, err := WeightedNorm(vector, weights, 0); err == nil {
+		t.Errorf("Expected error")
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic")
+		}
+	}()
+	MustWeightedNorm(vector, weights, -1)
+}
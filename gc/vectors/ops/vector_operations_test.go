@@ -298,3 +298,34 @@ func TestOuterProduct(t *testing.T) {
 		t.Error("Expected error")
 	}
 }
+
+func TestKron(t *testing.T) {
+	testVectorA := v.MakeVector(v.ColSpace, 1, 2)
+	testVectorB := v.MakeVector(v.ColSpace, 3, 1i, 5)
+
+	result, err := Kron(testVectorA, testVectorB)
+	solution := v.MakeVector(v.ColSpace, 3, 1i, 5, 6, 2i, 10)
+	if err != nil || !reflect.DeepEqual(result, solution) {
+		t.Errorf("Expected %v, received %v", solution, result)
+	}
+
+	if _, err := Kron(testVectorA, v.MakeVector(v.RowSpace, 3, 4)); err == nil {
+		t.Errorf("Expected error")
+	}
+}
+
+func TestAddSubQuantity(t *testing.T) {
+	metres := v.MakeVector(v.RowSpace, gcv.MustMakeQuantity(1, "m"), gcv.MustMakeQuantity(2, "m"))
+	centimetres := v.MakeVector(v.RowSpace, gcv.MustMakeQuantity(50, "cm"), gcv.MustMakeQuantity(100, "cm"))
+	seconds := v.MakeVector(v.RowSpace, gcv.MustMakeQuantity(1, "s"), gcv.MustMakeQuantity(2, "s"))
+
+	if sum, err := Add(metres, centimetres); err != nil || sum.Get(0).Real() != 1.5 || sum.Get(1).Real() != 3 {
+		t.Errorf("Expected [1.5 m, 3 m], received %v, %v", sum, err)
+	}
+	if _, err := Add(metres, seconds); err == nil {
+		t.Errorf("Expected error adding metres and seconds")
+	}
+	if _, err := Sub(v.MakeVector(v.RowSpace, 1, 2), seconds); err == nil {
+		t.Errorf("Expected error subtracting seconds from numbers")
+	}
+}
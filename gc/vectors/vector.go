@@ -108,7 +108,7 @@ func (v *vector) Trans() {
 
 // implementation of Conj method
 func (v *vector) Conj() {
-	if v.Type() == gcv.Complex {
+	if v.Type() != gcv.Real {
 		for i := 0; i < v.Len(); i++ {
 			value := v.Get(i)
 			v.Set(i, gcvops.Conj(value))
@@ -128,12 +128,12 @@ func (v *vectors) Subset(start, finish int) Vectors {
 
 func (v *vectors) IndexOf(vect Vector) int {
 	found := false
-	values := gcv.RetrieveValues(vect.Elements())
 	for index, vector := range v.Vectors() {
 		if vect.Len() != vector.Len() {
 			continue
 		}
-		for valIndex, value := range values {
+		for valIndex := 0; valIndex < vect.Len(); valIndex++ {
+			value := vect.Get(valIndex)
 			tempValue := vector.Get(valIndex)
 			if value.Type() == gcv.Complex && value.Complex() != tempValue.Complex() {
 				found = false
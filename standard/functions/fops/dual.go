@@ -0,0 +1,56 @@
+package fops
+
+import "math"
+
+// Dual is a dual number Real + Eps*e where e*e = 0.
+// Evaluating a function at Real + 1*e gives the function value in Real and its derivative in Eps
+type Dual struct {
+	Real float64
+	Eps  float64
+}
+
+// MakeDual returns the Dual number real + eps*e
+func MakeDual(real float64, eps float64) Dual { return Dual{Real: real, Eps: eps} }
+
+// chainDual returns g(a) for a function g with g(a.Real) = g0 and g'(a.Real) = g1
+func chainDual(a Dual, g0 float64, g1 float64) Dual {
+	return Dual{Real: g0, Eps: g1 * a.Eps}
+}
+
+// chainDual2 returns g(a, b) for a function g with value g0 and partial derivatives ga and gb
+func chainDual2(a Dual, b Dual, g0 float64, ga float64, gb float64) Dual {
+	return Dual{Real: g0, Eps: ga*a.Eps + gb*b.Eps}
+}
+
+func multDual(a Dual, b Dual) Dual {
+	return Dual{Real: a.Real * b.Real, Eps: a.Real*b.Eps + a.Eps*b.Real}
+}
+
+func divDual(a Dual, b Dual) Dual {
+	return Dual{Real: a.Real / b.Real, Eps: (a.Eps*b.Real - a.Real*b.Eps) / (b.Real * b.Real)}
+}
+
+// addDual returns a + scale*b
+func addDual(a Dual, b Dual, scale float64) Dual {
+	return Dual{Real: a.Real + scale*b.Real, Eps: a.Eps + scale*b.Eps}
+}
+
+func negateDual(a Dual) Dual { return Dual{Real: -a.Real, Eps: -a.Eps} }
+
+// powTerm returns c*x^n, or 0 if c is 0 so a term that vanishes stays 0 where x^n is not finite
+func powTerm(c float64, x float64, n float64) float64 {
+	if c == 0 {
+		return 0
+	}
+	return c * math.Pow(x, n)
+}
+
+// sign returns -1, 0 or 1 for the sign of x
+func sign(x float64) float64 {
+	if x > 0 {
+		return 1
+	} else if x < 0 {
+		return -1
+	}
+	return 0
+}
@@ -0,0 +1,323 @@
+package fops
+
+import "math"
+
+// VariableDual returns a dual variable
+func VariableDual(index int) (f func(x ...Dual) Dual) {
+	f = func(x ...Dual) Dual {
+		return x[index]
+	}
+	return
+}
+
+// NegativeVariableDual returns a negative dual variable
+func NegativeVariableDual(index int) (f func(x ...Dual) Dual) {
+	f = func(x ...Dual) Dual {
+		return negateDual(x[index])
+	}
+	return
+}
+
+// ConstantDual returns a constant
+func ConstantDual(constant float64) (f func(x ...Dual) Dual) {
+	f = func(x ...Dual) Dual {
+		return Dual{Real: constant}
+	}
+	return
+}
+
+// ParensDual returns the identity of f(x...)
+func ParensDual(f func(x ...Dual) Dual) (h func(x ...Dual) Dual) {
+	h = func(x ...Dual) Dual {
+		return (f(x...))
+	}
+	return
+}
+
+// AddDual returns the addition of two functions
+func AddDual(f func(x ...Dual) Dual, g func(x ...Dual) Dual) (h func(x ...Dual) Dual) {
+	h = func(x ...Dual) Dual {
+		return addDual(f(x...), g(x...), 1)
+	}
+	return
+}
+
+// SubtractDual returns the subtraction of two functions
+func SubtractDual(f func(x ...Dual) Dual, g func(x ...Dual) Dual) (h func(x ...Dual) Dual) {
+	h = func(x ...Dual) Dual {
+		return addDual(f(x...), g(x...), -1)
+	}
+	return
+}
+
+// DivideDual returns the division of two functions
+func DivideDual(f func(x ...Dual) Dual, g func(x ...Dual) Dual) (h func(x ...Dual) Dual) {
+	h = func(x ...Dual) Dual {
+		return divDual(f(x...), g(x...))
+	}
+	return
+}
+
+// MultipleDual returns the multiplication of two functions
+func MultipleDual(f func(x ...Dual) Dual, g func(x ...Dual) Dual) (h func(x ...Dual) Dual) {
+	h = func(x ...Dual) Dual {
+		return multDual(f(x...), g(x...))
+	}
+	return
+}
+
+// SquareRootDual returns the square root of a function
+func SquareRootDual(f func(x ...Dual) Dual) (h func(x ...Dual) Dual) {
+	h = func(x ...Dual) Dual {
+		a := f(x...)
+		r := a.Real
+		s := math.Sqrt(r)
+		return chainDual(a, s, 1/(2*s))
+	}
+	return
+}
+
+// AbsoluteValueDual returns the absolute value of a function
+func AbsoluteValueDual(f func(x ...Dual) Dual) (h func(x ...Dual) Dual) {
+	h = func(x ...Dual) Dual {
+		a := f(x...)
+		r := a.Real
+		return chainDual(a, math.Abs(r), sign(r))
+	}
+	return
+}
+
+// SineDual returns the sine of a function
+func SineDual(f func(x ...Dual) Dual) (h func(x ...Dual) Dual) {
+	h = func(x ...Dual) Dual {
+		a := f(x...)
+		r := a.Real
+		return chainDual(a, math.Sin(r), math.Cos(r))
+	}
+	return
+}
+
+// CosineDual returns the cosine of a function
+func CosineDual(f func(x ...Dual) Dual) (h func(x ...Dual) Dual) {
+	h = func(x ...Dual) Dual {
+		a := f(x...)
+		r := a.Real
+		return chainDual(a, math.Cos(r), -math.Sin(r))
+	}
+	return
+}
+
+// TangentDual returns the tangent of a function
+func TangentDual(f func(x ...Dual) Dual) (h func(x ...Dual) Dual) {
+	h = func(x ...Dual) Dual {
+		a := f(x...)
+		r := a.Real
+		t := math.Tan(r)
+		return chainDual(a, t, 1+t*t)
+	}
+	return
+}
+
+// ArcsineDual returns the arcsine of a function
+func ArcsineDual(f func(x ...Dual) Dual) (h func(x ...Dual) Dual) {
+	h = func(x ...Dual) Dual {
+		a := f(x...)
+		r := a.Real
+		d := 1 - r*r
+		return chainDual(a, math.Asin(r), 1/math.Sqrt(d))
+	}
+	return
+}
+
+// ArccosineDual returns the arccosine of a function
+func ArccosineDual(f func(x ...Dual) Dual) (h func(x ...Dual) Dual) {
+	h = func(x ...Dual) Dual {
+		a := f(x...)
+		r := a.Real
+		d := 1 - r*r
+		return chainDual(a, math.Acos(r), -1/math.Sqrt(d))
+	}
+	return
+}
+
+// ArctangentDual returns the arctangent of a function
+func ArctangentDual(f func(x ...Dual) Dual) (h func(x ...Dual) Dual) {
+	h = func(x ...Dual) Dual {
+		a := f(x...)
+		r := a.Real
+		d := 1 + r*r
+		return chainDual(a, math.Atan(r), 1/d)
+	}
+	return
+}
+
+// HyperbolicSineDual returns the hyperbolicSine of a function
+func HyperbolicSineDual(f func(x ...Dual) Dual) (h func(x ...Dual) Dual) {
+	h = func(x ...Dual) Dual {
+		a := f(x...)
+		r := a.Real
+		return chainDual(a, math.Sinh(r), math.Cosh(r))
+	}
+	return
+}
+
+// HyperbolicCosineDual returns the hyperbolicCosine of a function
+func HyperbolicCosineDual(f func(x ...Dual) Dual) (h func(x ...Dual) Dual) {
+	h = func(x ...Dual) Dual {
+		a := f(x...)
+		r := a.Real
+		return chainDual(a, math.Cosh(r), math.Sinh(r))
+	}
+	return
+}
+
+// HyperbolicTangentDual returns the hyperbolicTangent of a function
+func HyperbolicTangentDual(f func(x ...Dual) Dual) (h func(x ...Dual) Dual) {
+	h = func(x ...Dual) Dual {
+		a := f(x...)
+		r := a.Real
+		t := math.Tanh(r)
+		return chainDual(a, t, 1-t*t)
+	}
+	return
+}
+
+// InverseHyperbolicSineDual returns the inverseHyperbolicSine of a function
+func InverseHyperbolicSineDual(f func(x ...Dual) Dual) (h func(x ...Dual) Dual) {
+	h = func(x ...Dual) Dual {
+		a := f(x...)
+		r := a.Real
+		d := r*r + 1
+		return chainDual(a, math.Asinh(r), 1/math.Sqrt(d))
+	}
+	return
+}
+
+// InverseHyperbolicCosineDual returns the inverseHyperbolicCosine of a function
+func InverseHyperbolicCosineDual(f func(x ...Dual) Dual) (h func(x ...Dual) Dual) {
+	h = func(x ...Dual) Dual {
+		a := f(x...)
+		r := a.Real
+		d := r*r - 1
+		return chainDual(a, math.Acosh(r), 1/math.Sqrt(d))
+	}
+	return
+}
+
+// InverseHyperbolicTangentDual returns the inverseHyperbolicTangent of a function
+func InverseHyperbolicTangentDual(f func(x ...Dual) Dual) (h func(x ...Dual) Dual) {
+	h = func(x ...Dual) Dual {
+		a := f(x...)
+		r := a.Real
+		d := 1 - r*r
+		return chainDual(a, math.Atanh(r), 1/d)
+	}
+	return
+}
+
+// FloorDual returns the floor (rounded down) of a function. The derivative is taken to be 0
+func FloorDual(f func(x ...Dual) Dual) (h func(x ...Dual) Dual) {
+	h = func(x ...Dual) Dual {
+		a := f(x...)
+		r := a.Real
+		return chainDual(a, math.Floor(r), 0)
+	}
+	return
+}
+
+// CeilDual returns the ceil (rounded up) of a function. The derivative is taken to be 0
+func CeilDual(f func(x ...Dual) Dual) (h func(x ...Dual) Dual) {
+	h = func(x ...Dual) Dual {
+		a := f(x...)
+		r := a.Real
+		return chainDual(a, math.Ceil(r), 0)
+	}
+	return
+}
+
+// ErrorFunctionDual returns the error function of a function
+func ErrorFunctionDual(f func(x ...Dual) Dual) (h func(x ...Dual) Dual) {
+	h = func(x ...Dual) Dual {
+		a := f(x...)
+		r := a.Real
+		e := 2 / math.SqrtPi * math.Exp(-r*r)
+		return chainDual(a, math.Erf(r), e)
+	}
+	return
+}
+
+// Arctangent2Dual returns the arctangent using the signs of the two functions to tell which
+// quadrant the resulting function is in. h(x...) = Atan(f(x...)/g(x...))
+func Arctangent2Dual(f func(x ...Dual) Dual, g func(x ...Dual) Dual) (h func(x ...Dual) Dual) {
+	h = func(x ...Dual) Dual {
+		a, b := f(x...), g(x...)
+		d := a.Real*a.Real + b.Real*b.Real
+		return chainDual2(a, b, math.Atan2(a.Real, b.Real), b.Real/d, -a.Real/d)
+	}
+	return
+}
+
+// LogBaseGxDual returns the log of two functions
+// g(x) will typically be a constant function
+func LogBaseGxDual(f func(x ...Dual) Dual, g func(x ...Dual) Dual) (h func(x ...Dual) Dual) {
+	h = func(x ...Dual) Dual {
+		a, b := f(x...), g(x...)
+		return divDual(chainDual(a, math.Log(a.Real), 1/a.Real), chainDual(b, math.Log(b.Real), 1/b.Real))
+	}
+	return
+}
+
+// PowerDual returns the power of a function raised two another function
+func PowerDual(f func(x ...Dual) Dual, g func(x ...Dual) Dual) (h func(x ...Dual) Dual) {
+	h = func(x ...Dual) Dual {
+		a, b := f(x...), g(x...)
+		p := math.Pow(a.Real, b.Real)
+		ga := powTerm(b.Real, a.Real, b.Real-1)
+		if b.Eps == 0 {
+			return chainDual(a, p, ga)
+		}
+		var gb float64
+		if p != 0 {
+			gb = p * math.Log(a.Real)
+		}
+		return chainDual2(a, b, p, ga, gb)
+	}
+	return
+}
+
+// ModuloDual returns the modulo of a function
+func ModuloDual(f func(x ...Dual) Dual, g func(x ...Dual) Dual) (h func(x ...Dual) Dual) {
+	h = func(x ...Dual) Dual {
+		a, b := f(x...), g(x...)
+		// the quotient is taken from math.Mod so it matches the Real part
+		r := math.Mod(a.Real, b.Real)
+		result := addDual(a, b, -math.Round((a.Real-r)/b.Real))
+		result.Real = r
+		return result
+	}
+	return
+}
+
+// MaxDual returns the max of two functions
+func MaxDual(f func(x ...Dual) Dual, g func(x ...Dual) Dual) (h func(x ...Dual) Dual) {
+	h = func(x ...Dual) Dual {
+		a, b := f(x...), g(x...)
+		if b.Real > a.Real {
+			return b
+		}
+		return a
+	}
+	return
+}
+
+// MinDual returns the minimum of two functions
+func MinDual(f func(x ...Dual) Dual, g func(x ...Dual) Dual) (h func(x ...Dual) Dual) {
+	h = func(x ...Dual) Dual {
+		a, b := f(x...), g(x...)
+		if b.Real < a.Real {
+			return b
+		}
+		return a
+	}
+	return
+}
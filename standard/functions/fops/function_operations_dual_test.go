@@ -0,0 +1,182 @@
+package fops
+
+import (
+	"math"
+	"testing"
+)
+
+type unaryBuilders struct {
+	name      string
+	real      func(f func(x ...float64) float64) func(x ...float64) float64
+	dual      func(f func(x ...Dual) Dual) func(x ...Dual) Dual
+	hyperDual func(f func(x ...HyperDual) HyperDual) func(x ...HyperDual) HyperDual
+	x         float64
+}
+
+var unaryBuilderTests = []unaryBuilders{
+	{"SquareRoot", SquareRoot, SquareRootDual, SquareRootHyperDual, 2},
+	{"AbsoluteValue", AbsoluteValue, AbsoluteValueDual, AbsoluteValueHyperDual, -2},
+	{"Sine", Sine, SineDual, SineHyperDual, 0.5},
+	{"Cosine", Cosine, CosineDual, CosineHyperDual, 0.5},
+	{"Tangent", Tangent, TangentDual, TangentHyperDual, 0.5},
+	{"Arcsine", Arcsine, ArcsineDual, ArcsineHyperDual, 0.5},
+	{"Arccosine", Arccosine, ArccosineDual, ArccosineHyperDual, 0.5},
+	{"Arctangent", Arctangent, ArctangentDual, ArctangentHyperDual, 0.5},
+	{"HyperbolicSine", HyperbolicSine, HyperbolicSineDual, HyperbolicSineHyperDual, 0.5},
+	{"HyperbolicCosine", HyperbolicCosine, HyperbolicCosineDual, HyperbolicCosineHyperDual, 0.5},
+	{"HyperbolicTangent", HyperbolicTangent, HyperbolicTangentDual, HyperbolicTangentHyperDual, 0.5},
+	{"InverseHyperbolicSine", InverseHyperbolicSine, InverseHyperbolicSineDual, InverseHyperbolicSineHyperDual, 0.5},
+	{"InverseHyperbolicCosine", InverseHyperbolicCosine, InverseHyperbolicCosineDual, InverseHyperbolicCosineHyperDual, 1.5},
+	{"InverseHyperbolicTangent", InverseHyperbolicTangent, InverseHyperbolicTangentDual, InverseHyperbolicTangentHyperDual, 0.5},
+	{"Floor", Floor, FloorDual, FloorHyperDual, 1.5},
+	{"Ceil", Ceil, CeilDual, CeilHyperDual, 1.5},
+	{"ErrorFunction", ErrorFunction, ErrorFunctionDual, ErrorFunctionHyperDual, 0.5},
+}
+
+// centralDifferences returns finite difference approximations of the first and second derivatives
+func centralDifferences(f func(x ...float64) float64, x float64) (first float64, second float64) {
+	step := 1e-4
+	first = (f(x+step) - f(x-step)) / (2 * step)
+	second = (f(x+step) - 2*f(x) + f(x-step)) / (step * step)
+	return
+}
+
+func TestUnaryDualBuilders(t *testing.T) {
+	for _, test := range unaryBuilderTests {
+		realFunction := test.real(Variable(0))
+		dualFunction := test.dual(VariableDual(0))
+		hyperDualFunction := test.hyperDual(VariableHyperDual(0))
+		first, second := centralDifferences(realFunction, test.x)
+
+		dualResult := dualFunction(MakeDual(test.x, 1))
+		if dualResult.Real != realFunction(test.x) || math.Abs(dualResult.Eps-first) > 1e-6 {
+			t.Errorf("%s: Expected (%v, %v), received %v", test.name, realFunction(test.x), first, dualResult)
+		}
+
+		hyperDualResult := hyperDualFunction(MakeHyperDual(test.x, 1, 1, 0))
+		if hyperDualResult.Real != realFunction(test.x) || hyperDualResult.Eps1 != dualResult.Eps ||
+			hyperDualResult.Eps2 != dualResult.Eps || math.Abs(hyperDualResult.Eps12-second) > 1e-4 {
+			t.Errorf("%s: Expected (%v, %v, %v, %v), received %v", test.name, realFunction(test.x), first, first, second, hyperDualResult)
+		}
+	}
+}
+
+func TestBinaryDualBuilders(t *testing.T) {
+	type binaryBuilders struct {
+		name      string
+		real      func(f func(x ...float64) float64, g func(x ...float64) float64) func(x ...float64) float64
+		dual      func(f func(x ...Dual) Dual, g func(x ...Dual) Dual) func(x ...Dual) Dual
+		hyperDual func(f func(x ...HyperDual) HyperDual, g func(x ...HyperDual) HyperDual) func(x ...HyperDual) HyperDual
+	}
+
+	tests := []binaryBuilders{
+		{"Add", Add, AddDual, AddHyperDual},
+		{"Subtract", Subtract, SubtractDual, SubtractHyperDual},
+		{"Multiple", Multiple, MultipleDual, MultipleHyperDual},
+		{"Divide", Divide, DivideDual, DivideHyperDual},
+		{"Arctangent2", Arctangent2, Arctangent2Dual, Arctangent2HyperDual},
+		{"LogBaseGx", LogBaseGx, LogBaseGxDual, LogBaseGxHyperDual},
+		{"Power", Power, PowerDual, PowerHyperDual},
+		{"Modulo", Modulo, ModuloDual, ModuloHyperDual},
+		{"Max", Max, MaxDual, MaxHyperDual},
+		{"Min", Min, MinDual, MinHyperDual},
+	}
+
+	x := []float64{2.5, 1.5}
+	for _, test := range tests {
+		realFunction := test.real(Variable(0), Variable(1))
+		gradient := Gradient(test.dual(VariableDual(0), VariableDual(1)), x...)
+		hessian := Hessian(test.hyperDual(VariableHyperDual(0), VariableHyperDual(1)), x...)
+
+		for i := range x {
+			partial := func(y ...float64) float64 {
+				point := []float64{x[0], x[1]}
+				point[i] = y[0]
+				return realFunction(point...)
+			}
+			first, second := centralDifferences(partial, x[i])
+			if math.Abs(gradient[i]-first) > 1e-6 {
+				t.Errorf("%s: Expected %v, received %v", test.name, first, gradient[i])
+			}
+			if math.Abs(hessian[i][i]-second) > 1e-4 {
+				t.Errorf("%s: Expected %v, received %v", test.name, second, hessian[i][i])
+			}
+		}
+
+		step := 1e-4
+		mixed := (realFunction(x[0]+step, x[1]+step) - realFunction(x[0]+step, x[1]-step) -
+			realFunction(x[0]-step, x[1]+step) + realFunction(x[0]-step, x[1]-step)) / (4 * step * step)
+		if math.Abs(hessian[0][1]-mixed) > 1e-4 || hessian[0][1] != hessian[1][0] {
+			t.Errorf("%s: Expected %v, received %v", test.name, mixed, hessian[0][1])
+		}
+	}
+}
+
+func TestVariableConstantParensDual(t *testing.T) {
+	dualFunction := ParensDual(AddDual(NegativeVariableDual(0), ConstantDual(3)))
+	result := dualFunction(MakeDual(2, 1))
+	if result.Real != 1 || result.Eps != -1 {
+		t.Errorf("Expected %v, received %v", MakeDual(1, -1), result)
+	}
+
+	hyperDualFunction := ParensHyperDual(AddHyperDual(NegativeVariableHyperDual(0), ConstantHyperDual(3)))
+	hyperResult := hyperDualFunction(MakeHyperDual(2, 1, 1, 0))
+	if hyperResult != MakeHyperDual(1, -1, -1, 0) {
+		t.Errorf("Expected %v, received %v", MakeHyperDual(1, -1, -1, 0), hyperResult)
+	}
+}
+
+func TestPowerNegativeBase(t *testing.T) {
+	// x^3 at a negative x has a derivative even though log(x) is not defined
+	cube := PowerDual(VariableDual(0), ConstantDual(3))
+	if derivative := Derivative(cube, -2); derivative != 12 {
+		t.Errorf("Expected %v, received %v", 12, derivative)
+	}
+
+	cubeHyperDual := PowerHyperDual(VariableHyperDual(0), ConstantHyperDual(3))
+	if second := Hessian(cubeHyperDual, -2)[0][0]; second != -12 {
+		t.Errorf("Expected %v, received %v", -12, second)
+	}
+}
+
+func TestPowerAtZero(t *testing.T) {
+	// x^0, x^1 and x^2 at x = 0, where a power of x in the derivatives is not finite
+	tests := []struct {
+		exponent float64
+		first    float64
+		second   float64
+	}{
+		{0, 0, 0},
+		{1, 1, 0},
+		{2, 0, 2},
+	}
+
+	for _, test := range tests {
+		power := PowerDual(VariableDual(0), ConstantDual(test.exponent))
+		if derivative := Derivative(power, 0); derivative != test.first {
+			t.Errorf("Expected %v for x^%v, received %v", test.first, test.exponent, derivative)
+		}
+
+		powerHyperDual := PowerHyperDual(VariableHyperDual(0), ConstantHyperDual(test.exponent))
+		if second := Hessian(powerHyperDual, 0)[0][0]; second != test.second {
+			t.Errorf("Expected %v for x^%v, received %v", test.second, test.exponent, second)
+		}
+	}
+
+	// 0^y has a derivative of 0 in y for y > 0
+	if gradient := Gradient(PowerDual(VariableDual(0), VariableDual(1)), 0, 2); gradient[0] != 0 || gradient[1] != 0 {
+		t.Errorf("Expected %v, received %v", []float64{0, 0}, gradient)
+	}
+}
+
+func TestModuloReal(t *testing.T) {
+	modulo := ModuloDual(VariableDual(0), ConstantDual(0.1))
+	if result := modulo(MakeDual(1, 1)); result.Real != math.Mod(1, 0.1) || result.Eps != 1 {
+		t.Errorf("Expected %v, received %v", MakeDual(math.Mod(1, 0.1), 1), result)
+	}
+
+	moduloHyperDual := ModuloHyperDual(VariableHyperDual(0), ConstantHyperDual(0.1))
+	if result := moduloHyperDual(MakeHyperDual(1, 1, 1, 0)); result.Real != math.Mod(1, 0.1) {
+		t.Errorf("Expected %v, received %v", math.Mod(1, 0.1), result.Real)
+	}
+}
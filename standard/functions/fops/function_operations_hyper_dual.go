@@ -0,0 +1,321 @@
+package fops
+
+import "math"
+
+// VariableHyperDual returns a hyper-dual variable
+func VariableHyperDual(index int) (f func(x ...HyperDual) HyperDual) {
+	f = func(x ...HyperDual) HyperDual {
+		return x[index]
+	}
+	return
+}
+
+// NegativeVariableHyperDual returns a negative hyper-dual variable
+func NegativeVariableHyperDual(index int) (f func(x ...HyperDual) HyperDual) {
+	f = func(x ...HyperDual) HyperDual {
+		return negateHyperDual(x[index])
+	}
+	return
+}
+
+// ConstantHyperDual returns a constant
+func ConstantHyperDual(constant float64) (f func(x ...HyperDual) HyperDual) {
+	f = func(x ...HyperDual) HyperDual {
+		return HyperDual{Real: constant}
+	}
+	return
+}
+
+// ParensHyperDual returns the identity of f(x...)
+func ParensHyperDual(f func(x ...HyperDual) HyperDual) (h func(x ...HyperDual) HyperDual) {
+	h = func(x ...HyperDual) HyperDual {
+		return (f(x...))
+	}
+	return
+}
+
+// AddHyperDual returns the addition of two functions
+func AddHyperDual(f func(x ...HyperDual) HyperDual, g func(x ...HyperDual) HyperDual) (h func(x ...HyperDual) HyperDual) {
+	h = func(x ...HyperDual) HyperDual {
+		return addHyperDual(f(x...), g(x...), 1)
+	}
+	return
+}
+
+// SubtractHyperDual returns the subtraction of two functions
+func SubtractHyperDual(f func(x ...HyperDual) HyperDual, g func(x ...HyperDual) HyperDual) (h func(x ...HyperDual) HyperDual) {
+	h = func(x ...HyperDual) HyperDual {
+		return addHyperDual(f(x...), g(x...), -1)
+	}
+	return
+}
+
+// DivideHyperDual returns the division of two functions
+func DivideHyperDual(f func(x ...HyperDual) HyperDual, g func(x ...HyperDual) HyperDual) (h func(x ...HyperDual) HyperDual) {
+	h = func(x ...HyperDual) HyperDual {
+		return multHyperDual(f(x...), invHyperDual(g(x...)))
+	}
+	return
+}
+
+// MultipleHyperDual returns the multiplication of two functions
+func MultipleHyperDual(f func(x ...HyperDual) HyperDual, g func(x ...HyperDual) HyperDual) (h func(x ...HyperDual) HyperDual) {
+	h = func(x ...HyperDual) HyperDual {
+		return multHyperDual(f(x...), g(x...))
+	}
+	return
+}
+
+// SquareRootHyperDual returns the square root of a function
+func SquareRootHyperDual(f func(x ...HyperDual) HyperDual) (h func(x ...HyperDual) HyperDual) {
+	h = func(x ...HyperDual) HyperDual {
+		a := f(x...)
+		r := a.Real
+		s := math.Sqrt(r)
+		return chainHyperDual(a, s, 1/(2*s), -1/(4*r*s))
+	}
+	return
+}
+
+// AbsoluteValueHyperDual returns the absolute value of a function
+func AbsoluteValueHyperDual(f func(x ...HyperDual) HyperDual) (h func(x ...HyperDual) HyperDual) {
+	h = func(x ...HyperDual) HyperDual {
+		a := f(x...)
+		r := a.Real
+		return chainHyperDual(a, math.Abs(r), sign(r), 0)
+	}
+	return
+}
+
+// SineHyperDual returns the sine of a function
+func SineHyperDual(f func(x ...HyperDual) HyperDual) (h func(x ...HyperDual) HyperDual) {
+	h = func(x ...HyperDual) HyperDual {
+		a := f(x...)
+		r := a.Real
+		return chainHyperDual(a, math.Sin(r), math.Cos(r), -math.Sin(r))
+	}
+	return
+}
+
+// CosineHyperDual returns the cosine of a function
+func CosineHyperDual(f func(x ...HyperDual) HyperDual) (h func(x ...HyperDual) HyperDual) {
+	h = func(x ...HyperDual) HyperDual {
+		a := f(x...)
+		r := a.Real
+		return chainHyperDual(a, math.Cos(r), -math.Sin(r), -math.Cos(r))
+	}
+	return
+}
+
+// TangentHyperDual returns the tangent of a function
+func TangentHyperDual(f func(x ...HyperDual) HyperDual) (h func(x ...HyperDual) HyperDual) {
+	h = func(x ...HyperDual) HyperDual {
+		a := f(x...)
+		r := a.Real
+		t := math.Tan(r)
+		return chainHyperDual(a, t, 1+t*t, 2*t*(1+t*t))
+	}
+	return
+}
+
+// ArcsineHyperDual returns the arcsine of a function
+func ArcsineHyperDual(f func(x ...HyperDual) HyperDual) (h func(x ...HyperDual) HyperDual) {
+	h = func(x ...HyperDual) HyperDual {
+		a := f(x...)
+		r := a.Real
+		d := 1 - r*r
+		return chainHyperDual(a, math.Asin(r), 1/math.Sqrt(d), r/(d*math.Sqrt(d)))
+	}
+	return
+}
+
+// ArccosineHyperDual returns the arccosine of a function
+func ArccosineHyperDual(f func(x ...HyperDual) HyperDual) (h func(x ...HyperDual) HyperDual) {
+	h = func(x ...HyperDual) HyperDual {
+		a := f(x...)
+		r := a.Real
+		d := 1 - r*r
+		return chainHyperDual(a, math.Acos(r), -1/math.Sqrt(d), -r/(d*math.Sqrt(d)))
+	}
+	return
+}
+
+// ArctangentHyperDual returns the arctangent of a function
+func ArctangentHyperDual(f func(x ...HyperDual) HyperDual) (h func(x ...HyperDual) HyperDual) {
+	h = func(x ...HyperDual) HyperDual {
+		a := f(x...)
+		r := a.Real
+		d := 1 + r*r
+		return chainHyperDual(a, math.Atan(r), 1/d, -2*r/(d*d))
+	}
+	return
+}
+
+// HyperbolicSineHyperDual returns the hyperbolicSine of a function
+func HyperbolicSineHyperDual(f func(x ...HyperDual) HyperDual) (h func(x ...HyperDual) HyperDual) {
+	h = func(x ...HyperDual) HyperDual {
+		a := f(x...)
+		r := a.Real
+		return chainHyperDual(a, math.Sinh(r), math.Cosh(r), math.Sinh(r))
+	}
+	return
+}
+
+// HyperbolicCosineHyperDual returns the hyperbolicCosine of a function
+func HyperbolicCosineHyperDual(f func(x ...HyperDual) HyperDual) (h func(x ...HyperDual) HyperDual) {
+	h = func(x ...HyperDual) HyperDual {
+		a := f(x...)
+		r := a.Real
+		return chainHyperDual(a, math.Cosh(r), math.Sinh(r), math.Cosh(r))
+	}
+	return
+}
+
+// HyperbolicTangentHyperDual returns the hyperbolicTangent of a function
+func HyperbolicTangentHyperDual(f func(x ...HyperDual) HyperDual) (h func(x ...HyperDual) HyperDual) {
+	h = func(x ...HyperDual) HyperDual {
+		a := f(x...)
+		r := a.Real
+		t := math.Tanh(r)
+		return chainHyperDual(a, t, 1-t*t, -2*t*(1-t*t))
+	}
+	return
+}
+
+// InverseHyperbolicSineHyperDual returns the inverseHyperbolicSine of a function
+func InverseHyperbolicSineHyperDual(f func(x ...HyperDual) HyperDual) (h func(x ...HyperDual) HyperDual) {
+	h = func(x ...HyperDual) HyperDual {
+		a := f(x...)
+		r := a.Real
+		d := r*r + 1
+		return chainHyperDual(a, math.Asinh(r), 1/math.Sqrt(d), -r/(d*math.Sqrt(d)))
+	}
+	return
+}
+
+// InverseHyperbolicCosineHyperDual returns the inverseHyperbolicCosine of a function
+func InverseHyperbolicCosineHyperDual(f func(x ...HyperDual) HyperDual) (h func(x ...HyperDual) HyperDual) {
+	h = func(x ...HyperDual) HyperDual {
+		a := f(x...)
+		r := a.Real
+		d := r*r - 1
+		return chainHyperDual(a, math.Acosh(r), 1/math.Sqrt(d), -r/(d*math.Sqrt(d)))
+	}
+	return
+}
+
+// InverseHyperbolicTangentHyperDual returns the inverseHyperbolicTangent of a function
+func InverseHyperbolicTangentHyperDual(f func(x ...HyperDual) HyperDual) (h func(x ...HyperDual) HyperDual) {
+	h = func(x ...HyperDual) HyperDual {
+		a := f(x...)
+		r := a.Real
+		d := 1 - r*r
+		return chainHyperDual(a, math.Atanh(r), 1/d, 2*r/(d*d))
+	}
+	return
+}
+
+// FloorHyperDual returns the floor (rounded down) of a function. The derivative is taken to be 0
+func FloorHyperDual(f func(x ...HyperDual) HyperDual) (h func(x ...HyperDual) HyperDual) {
+	h = func(x ...HyperDual) HyperDual {
+		a := f(x...)
+		r := a.Real
+		return chainHyperDual(a, math.Floor(r), 0, 0)
+	}
+	return
+}
+
+// CeilHyperDual returns the ceil (rounded up) of a function. The derivative is taken to be 0
+func CeilHyperDual(f func(x ...HyperDual) HyperDual) (h func(x ...HyperDual) HyperDual) {
+	h = func(x ...HyperDual) HyperDual {
+		a := f(x...)
+		r := a.Real
+		return chainHyperDual(a, math.Ceil(r), 0, 0)
+	}
+	return
+}
+
+// ErrorFunctionHyperDual returns the error function of a function
+func ErrorFunctionHyperDual(f func(x ...HyperDual) HyperDual) (h func(x ...HyperDual) HyperDual) {
+	h = func(x ...HyperDual) HyperDual {
+		a := f(x...)
+		r := a.Real
+		e := 2 / math.SqrtPi * math.Exp(-r*r)
+		return chainHyperDual(a, math.Erf(r), e, -2*r*e)
+	}
+	return
+}
+
+// Arctangent2HyperDual returns the arctangent using the signs of the two functions to tell which
+// quadrant the resulting function is in. h(x...) = Atan(f(x...)/g(x...))
+func Arctangent2HyperDual(f func(x ...HyperDual) HyperDual, g func(x ...HyperDual) HyperDual) (h func(x ...HyperDual) HyperDual) {
+	h = func(x ...HyperDual) HyperDual {
+		a, b := f(x...), g(x...)
+		d := a.Real*a.Real + b.Real*b.Real
+		return chainHyperDual2(a, b, math.Atan2(a.Real, b.Real), b.Real/d, -a.Real/d,
+			-2*a.Real*b.Real/(d*d), (a.Real*a.Real-b.Real*b.Real)/(d*d), 2*a.Real*b.Real/(d*d))
+	}
+	return
+}
+
+// LogBaseGxHyperDual returns the log of two functions
+// g(x) will typically be a constant function
+func LogBaseGxHyperDual(f func(x ...HyperDual) HyperDual, g func(x ...HyperDual) HyperDual) (h func(x ...HyperDual) HyperDual) {
+	h = func(x ...HyperDual) HyperDual {
+		a, b := f(x...), g(x...)
+		return multHyperDual(logHyperDual(a), invHyperDual(logHyperDual(b)))
+	}
+	return
+}
+
+// PowerHyperDual returns the power of a function raised two another function
+func PowerHyperDual(f func(x ...HyperDual) HyperDual, g func(x ...HyperDual) HyperDual) (h func(x ...HyperDual) HyperDual) {
+	h = func(x ...HyperDual) HyperDual {
+		a, b := f(x...), g(x...)
+		if b.Eps1 == 0 && b.Eps2 == 0 && b.Eps12 == 0 {
+			n := b.Real
+			return chainHyperDual(a, math.Pow(a.Real, n), powTerm(n, a.Real, n-1), powTerm(n*(n-1), a.Real, n-2))
+		}
+		exponent := multHyperDual(b, logHyperDual(a))
+		e := math.Exp(exponent.Real)
+		return chainHyperDual(exponent, e, e, e)
+	}
+	return
+}
+
+// ModuloHyperDual returns the modulo of a function
+func ModuloHyperDual(f func(x ...HyperDual) HyperDual, g func(x ...HyperDual) HyperDual) (h func(x ...HyperDual) HyperDual) {
+	h = func(x ...HyperDual) HyperDual {
+		a, b := f(x...), g(x...)
+		// the quotient is taken from math.Mod so it matches the Real part
+		r := math.Mod(a.Real, b.Real)
+		result := addHyperDual(a, b, -math.Round((a.Real-r)/b.Real))
+		result.Real = r
+		return result
+	}
+	return
+}
+
+// MaxHyperDual returns the max of two functions
+func MaxHyperDual(f func(x ...HyperDual) HyperDual, g func(x ...HyperDual) HyperDual) (h func(x ...HyperDual) HyperDual) {
+	h = func(x ...HyperDual) HyperDual {
+		a, b := f(x...), g(x...)
+		if b.Real > a.Real {
+			return b
+		}
+		return a
+	}
+	return
+}
+
+// MinHyperDual returns the minimum of two functions
+func MinHyperDual(f func(x ...HyperDual) HyperDual, g func(x ...HyperDual) HyperDual) (h func(x ...HyperDual) HyperDual) {
+	h = func(x ...HyperDual) HyperDual {
+		a, b := f(x...), g(x...)
+		if b.Real < a.Real {
+			return b
+		}
+		return a
+	}
+	return
+}
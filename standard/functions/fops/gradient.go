@@ -0,0 +1,44 @@
+package fops
+
+// Derivative returns the exact derivative of the single variable dual function f at x
+func Derivative(f func(x ...Dual) Dual, x float64) float64 {
+	return f(Dual{Real: x, Eps: 1}).Eps
+}
+
+// Gradient returns the exact gradient of the dual function f at x.
+// f is evaluated once for each variable
+func Gradient(f func(x ...Dual) Dual, x ...float64) []float64 {
+	gradient := make([]float64, len(x))
+	point := make([]Dual, len(x))
+	for i := range x {
+		for k, xk := range x {
+			point[k] = Dual{Real: xk}
+		}
+		point[i].Eps = 1
+		gradient[i] = f(point...).Eps
+	}
+	return gradient
+}
+
+// Hessian returns the exact matrix of second derivatives of the hyper-dual function f at x.
+// f is evaluated once for each pair of variables
+func Hessian(f func(x ...HyperDual) HyperDual, x ...float64) [][]float64 {
+	hessian := make([][]float64, len(x))
+	for i := range hessian {
+		hessian[i] = make([]float64, len(x))
+	}
+	point := make([]HyperDual, len(x))
+	for i := range x {
+		for j := i; j < len(x); j++ {
+			for k, xk := range x {
+				point[k] = HyperDual{Real: xk}
+			}
+			point[i].Eps1 = 1
+			point[j].Eps2 = 1
+			second := f(point...).Eps12
+			hessian[i][j] = second
+			hessian[j][i] = second
+		}
+	}
+	return hessian
+}
@@ -0,0 +1,51 @@
+package fops
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestDerivative(t *testing.T) {
+	// f(x) = sin(x^2)
+	f := SineDual(MultipleDual(VariableDual(0), VariableDual(0)))
+	solution := 2 * math.Cos(1)
+	if result := Derivative(f, 1); math.Abs(result-solution) > 1e-15 {
+		t.Errorf("Expected %v, received %v", solution, result)
+	}
+}
+
+func TestGradient(t *testing.T) {
+	// f(x, y, z) = x*y + z^2
+	f := AddDual(MultipleDual(VariableDual(0), VariableDual(1)), PowerDual(VariableDual(2), ConstantDual(2)))
+	result := Gradient(f, 1, 2, 3)
+	solution := []float64{2, 1, 6}
+	if !reflect.DeepEqual(result, solution) {
+		t.Errorf("Expected %v, received %v", solution, result)
+	}
+
+	if result = Gradient(f); len(result) != 0 {
+		t.Errorf("Expected %v, received %v", []float64{}, result)
+	}
+}
+
+func TestHessian(t *testing.T) {
+	// f(x, y) = x^2*y + exp(x*y) written as x^2*y + e^(x*y)
+	f := AddHyperDual(
+		MultipleHyperDual(PowerHyperDual(VariableHyperDual(0), ConstantHyperDual(2)), VariableHyperDual(1)),
+		PowerHyperDual(ConstantHyperDual(math.E), MultipleHyperDual(VariableHyperDual(0), VariableHyperDual(1))))
+	x, y := 1.0, 2.0
+	e := math.Exp(x * y)
+	solution := [][]float64{
+		{2*y + y*y*e, 2*x + e + x*y*e},
+		{2*x + e + x*y*e, x * x * e},
+	}
+	result := Hessian(f, x, y)
+	for i := range solution {
+		for j := range solution[i] {
+			if math.Abs(result[i][j]-solution[i][j]) > 1e-12 {
+				t.Errorf("Expected %v, received %v", solution, result)
+			}
+		}
+	}
+}
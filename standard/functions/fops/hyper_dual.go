@@ -0,0 +1,69 @@
+package fops
+
+import "math"
+
+// HyperDual is a hyper-dual number Real + Eps1*e1 + Eps2*e2 + Eps12*e1*e2 where e1*e1 = e2*e2 = 0.
+// Evaluating a function at x + e1*u + e2*v gives the directional first derivatives along u and v
+// in Eps1 and Eps2, and the second derivative along u and v in Eps12
+type HyperDual struct {
+	Real  float64
+	Eps1  float64
+	Eps2  float64
+	Eps12 float64
+}
+
+// MakeHyperDual returns the HyperDual number real + eps1*e1 + eps2*e2 + eps12*e1*e2
+func MakeHyperDual(real float64, eps1 float64, eps2 float64, eps12 float64) HyperDual {
+	return HyperDual{Real: real, Eps1: eps1, Eps2: eps2, Eps12: eps12}
+}
+
+// chainHyperDual returns g(a) for a function g with value g0 and first and second derivatives g1 and g2 at a.Real
+func chainHyperDual(a HyperDual, g0 float64, g1 float64, g2 float64) HyperDual {
+	return HyperDual{
+		Real:  g0,
+		Eps1:  g1 * a.Eps1,
+		Eps2:  g1 * a.Eps2,
+		Eps12: g1*a.Eps12 + g2*a.Eps1*a.Eps2,
+	}
+}
+
+// chainHyperDual2 returns g(a, b) for a function g with value g0, first partial derivatives
+// ga and gb and second partial derivatives gaa, gab and gbb
+func chainHyperDual2(a HyperDual, b HyperDual, g0, ga, gb, gaa, gab, gbb float64) HyperDual {
+	return HyperDual{
+		Real: g0,
+		Eps1: ga*a.Eps1 + gb*b.Eps1,
+		Eps2: ga*a.Eps2 + gb*b.Eps2,
+		Eps12: ga*a.Eps12 + gb*b.Eps12 + gaa*a.Eps1*a.Eps2 +
+			gab*(a.Eps1*b.Eps2+a.Eps2*b.Eps1) + gbb*b.Eps1*b.Eps2,
+	}
+}
+
+func multHyperDual(a HyperDual, b HyperDual) HyperDual {
+	return HyperDual{
+		Real:  a.Real * b.Real,
+		Eps1:  a.Real*b.Eps1 + a.Eps1*b.Real,
+		Eps2:  a.Real*b.Eps2 + a.Eps2*b.Real,
+		Eps12: a.Real*b.Eps12 + a.Eps1*b.Eps2 + a.Eps2*b.Eps1 + a.Eps12*b.Real,
+	}
+}
+
+func invHyperDual(a HyperDual) HyperDual {
+	return chainHyperDual(a, 1/a.Real, -1/(a.Real*a.Real), 2/(a.Real*a.Real*a.Real))
+}
+
+// addHyperDual returns a + scale*b
+func addHyperDual(a HyperDual, b HyperDual, scale float64) HyperDual {
+	return HyperDual{
+		Real:  a.Real + scale*b.Real,
+		Eps1:  a.Eps1 + scale*b.Eps1,
+		Eps2:  a.Eps2 + scale*b.Eps2,
+		Eps12: a.Eps12 + scale*b.Eps12,
+	}
+}
+
+func negateHyperDual(a HyperDual) HyperDual { return addHyperDual(HyperDual{}, a, -1) }
+
+func logHyperDual(a HyperDual) HyperDual {
+	return chainHyperDual(a, math.Log(a.Real), 1/a.Real, -1/(a.Real*a.Real))
+}